@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/inventory-extension-odg/pkg/config"
 	odgapi "github.com/gardener/inventory-extension-odg/pkg/odg/api/client"
 	odgclient "github.com/gardener/inventory-extension-odg/pkg/odg/client"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/tasks"
 )
 
 // NewWorkerCommand returns a new [cli.Command] for worker-related operations.
@@ -48,6 +49,13 @@ func NewWorkerCommand() *cli.Command {
 				Usage:   "start worker process",
 				Aliases: []string{"s"},
 				Action:  execWorkerStartCommand,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:    "dry-run",
+						Usage:   "compute and log changes without applying them to odg",
+						EnvVars: []string{"INVENTORY_EXTENSION_DRY_RUN"},
+					},
+				},
 			},
 			{
 				Name:    "ping",
@@ -111,14 +119,24 @@ func newWorker(ctx context.Context, conf *config.Config) *workerutils.Worker {
 // newOdgClient creates a new [odgapiclient.Client] instance based on the
 // provided [config.Config] settings.
 func newOdgClient(conf *config.Config) (*odgapi.Client, error) {
-	opts := []odgapi.Option{
-		odgapi.WithUserAgent(conf.ODG.UserAgent),
-	}
-
 	if conf.ODG.Endpoint == "" {
 		return nil, errors.New("odg: no api endpoint specified")
 	}
 
+	transportConf := odgapi.TransportConfig{
+		Timeout:             conf.ODG.HTTP.Timeout,
+		DialTimeout:         conf.ODG.HTTP.DialTimeout,
+		TLSHandshakeTimeout: conf.ODG.HTTP.TLSHandshakeTimeout,
+		CABundle:            conf.ODG.HTTP.CABundle,
+		InsecureSkipVerify:  conf.ODG.HTTP.InsecureSkipVerify,
+		ProxyURL:            conf.ODG.HTTP.ProxyURL,
+		MaxIdleConns:        conf.ODG.HTTP.MaxIdleConns,
+	}
+
+	opts := []odgapi.Option{
+		odgapi.WithUserAgent(conf.ODG.UserAgent),
+	}
+
 	if conf.ODG.Auth.Method == "" {
 		return nil, errors.New("odg: no auth method specified")
 	}
@@ -135,15 +153,101 @@ func newOdgClient(conf *config.Config) (*odgapi.Client, error) {
 			opts,
 			odgapi.WithGithubAuthentication(conf.ODG.Auth.Github.URL, conf.ODG.Auth.Github.Token),
 		)
+	case config.ODGAuthMethodMTLS:
+		if conf.ODG.Auth.MTLS.CertFile == "" {
+			return nil, errors.New("odg: no mtls certificate file specified")
+		}
+		if conf.ODG.Auth.MTLS.KeyFile == "" {
+			return nil, errors.New("odg: no mtls key file specified")
+		}
+		transportConf.CertFile = conf.ODG.Auth.MTLS.CertFile
+		transportConf.KeyFile = conf.ODG.Auth.MTLS.KeyFile
+		if conf.ODG.Auth.MTLS.CAFile != "" {
+			if transportConf.CABundle != "" {
+				return nil, errors.New("odg: both mtls ca file and http ca bundle specified")
+			}
+			transportConf.CABundle = conf.ODG.Auth.MTLS.CAFile
+		}
 	case config.ODGAuthMethodNone:
 		// No authentication, nothing to do here.
 	default:
 		return nil, fmt.Errorf("odg: unknown auth method %s", conf.ODG.Auth.Method)
 	}
 
+	httpClient, err := odgapi.NewHTTPClient(transportConf)
+	if err != nil {
+		return nil, fmt.Errorf("odg: %w", err)
+	}
+	opts = append(opts, odgapi.WithHTTPClient(httpClient))
+
+	if conf.ODG.Batch.ArtefactMetadataSize > 0 {
+		opts = append(opts, odgapi.WithArtefactMetadataBatchSize(conf.ODG.Batch.ArtefactMetadataSize))
+	}
+
+	if conf.ODG.Batch.RuntimeArtefactsSize > 0 {
+		opts = append(opts, odgapi.WithRuntimeArtefactsBatchSize(conf.ODG.Batch.RuntimeArtefactsSize))
+	}
+
+	if conf.ODG.Batch.Parallelism > 0 {
+		opts = append(opts, odgapi.WithParallelism(conf.ODG.Batch.Parallelism))
+	}
+
+	retryPolicy := odgapi.DefaultRetryPolicy
+	if conf.ODG.Retry.MaxRetries != 0 {
+		retryPolicy.MaxRetries = max(conf.ODG.Retry.MaxRetries, 0)
+	}
+	if conf.ODG.Retry.MinBackoff > 0 {
+		retryPolicy.MinBackoff = conf.ODG.Retry.MinBackoff
+	}
+	if conf.ODG.Retry.MaxBackoff > 0 {
+		retryPolicy.MaxBackoff = conf.ODG.Retry.MaxBackoff
+	} else {
+		// Only the min backoff may have been configured, which
+		// may exceed the default max backoff.
+		retryPolicy.MaxBackoff = max(retryPolicy.MaxBackoff, retryPolicy.MinBackoff)
+	}
+	opts = append(
+		opts,
+		odgapi.WithRetryPolicy(retryPolicy),
+		odgapi.WithRetryObserver(odgclient.ObserveRetry),
+	)
+
 	return odgapi.New(conf.ODG.Endpoint, opts...)
 }
 
+// configureTasks configures the task handlers based on the provided
+// [config.Config] settings.
+func configureTasks(conf *config.Config) {
+	guardrail := tasks.DefaultGuardrail
+	// Zero is a valid threshold, so only unset settings use the defaults.
+	if conf.Tasks.Guardrail.MaxDeletions != nil {
+		guardrail.MaxDeletions = max(*conf.Tasks.Guardrail.MaxDeletions, 0)
+	}
+	if conf.Tasks.Guardrail.MaxDeletionPercentage != nil {
+		guardrail.MaxDeletionPercentage = max(*conf.Tasks.Guardrail.MaxDeletionPercentage, 0)
+	}
+	tasks.SetDefaultGuardrail(guardrail)
+
+	limits := tasks.DefaultQueryLimits
+	if conf.Tasks.Query.StatementTimeout > 0 {
+		limits.StatementTimeout = conf.Tasks.Query.StatementTimeout
+	}
+	if conf.Tasks.Query.Role != "" {
+		limits.Role = conf.Tasks.Query.Role
+	}
+	if conf.Tasks.Query.MaxRows > 0 {
+		limits.MaxRows = conf.Tasks.Query.MaxRows
+	}
+	tasks.SetDefaultQueryLimits(limits)
+
+	for taskName, queries := range conf.Tasks.Queries {
+		for queryName, query := range queries {
+			tasks.DefaultQueryCatalog.Add(taskName, queryName, query)
+		}
+	}
+	tasks.SetAllowAdHocQueries(!conf.Tasks.Query.DisableAdHoc)
+}
+
 // execWorkerStartCommand starts the worker
 func execWorkerStartCommand(ctx *cli.Context) error {
 	// Parse config files for the extension
@@ -176,12 +280,18 @@ func execWorkerStartCommand(ctx *cli.Context) error {
 		"endpoint", conf.ODG.Endpoint,
 		"auth", conf.ODG.Auth.Method,
 	)
+	if conf.ODG.HTTP.InsecureSkipVerify {
+		slog.Warn("tls certificate verification of the open delivery gear api is disabled")
+	}
 	odgClient, err := newOdgClient(conf)
 	if err != nil {
 		return err
 	}
 
-	if conf.ODG.Auth.Method != config.ODGAuthMethodNone {
+	// Only `github' authentication method uses a token. With `mtls'
+	// authentication method the client certificate is presented on each
+	// connection instead.
+	if conf.ODG.Auth.Method == config.ODGAuthMethodGithub {
 		if err := odgClient.Authenticate(ctx.Context); err != nil {
 			return err
 		}
@@ -191,6 +301,38 @@ func execWorkerStartCommand(ctx *cli.Context) error {
 	}
 	odgclient.SetClient(odgClient)
 
+	// Configure the task handlers
+	configureTasks(conf)
+	tasks.SetForceDryRun(ctx.Bool("dry-run"))
+	slog.Info("task handlers", "dry_run", tasks.ForceDryRun)
+	slog.Info(
+		"mass deletion guardrail",
+		"max_deletions", tasks.DefaultGuardrail.MaxDeletions,
+		"max_deletion_percentage", tasks.DefaultGuardrail.MaxDeletionPercentage,
+	)
+	slog.Info(
+		"query limits",
+		"statement_timeout", tasks.DefaultQueryLimits.StatementTimeout,
+		"role", tasks.DefaultQueryLimits.Role,
+		"max_rows", tasks.DefaultQueryLimits.MaxRows,
+		"allow_adhoc", tasks.AllowAdHocQueries,
+	)
+	readOnly, err := tasks.IsReadOnlyUser(ctx.Context, db)
+	if err != nil {
+		return err
+	}
+	if !readOnly {
+		slog.Warn(
+			"transactions of the database user are not read-only by default",
+			"hint", "set default_transaction_read_only for the role of the database user",
+		)
+	}
+	for taskName, queries := range tasks.DefaultQueryCatalog {
+		for queryName := range queries {
+			slog.Info("registered query", "task", taskName, "name", queryName)
+		}
+	}
+
 	// Create a worker, register handlers and start it up
 	worker := newWorker(ctx.Context, conf)
 	worker.HandlersFromRegistry(registry.TaskRegistry)
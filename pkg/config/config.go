@@ -6,6 +6,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	coreconfig "github.com/gardener/inventory/pkg/core/config"
 )
@@ -19,6 +20,10 @@ const (
 	// Github for querying users' information.
 	ODGAuthMethodGithub = "github"
 
+	// ODGAuthMethodMTLS represents authentication method, which uses
+	// mutual TLS with a client certificate.
+	ODGAuthMethodMTLS = "mtls"
+
 	// ODGAuthMethodNone is the name of the method, in which the API client
 	// will use no authentication against the remote API service.
 	ODGAuthMethodNone = "none"
@@ -50,6 +55,56 @@ type Config struct {
 
 	// ODG provides the Open Delivery Gear configuration
 	ODG ODGConfig `yaml:"odg"`
+
+	// Tasks provides the configuration for the task handlers.
+	Tasks TasksConfig `yaml:"tasks"`
+}
+
+// TasksConfig represents the configuration for the task handlers.
+type TasksConfig struct {
+	// Guardrail specifies the settings for the mass deletion guardrail,
+	// which protects existing findings from being wiped out.
+	Guardrail GuardrailConfig `yaml:"guardrail"`
+
+	// Query specifies the limits, which apply when executing the queries
+	// for orphan resources against the database.
+	Query QueryConfig `yaml:"query"`
+
+	// Queries specifies additional named queries, keyed by task name and
+	// query name, which task payloads may refer to.
+	Queries map[string]map[string]string `yaml:"queries"`
+}
+
+// QueryConfig provides the configuration for executing the queries for orphan
+// resources against the database.
+type QueryConfig struct {
+	// StatementTimeout specifies the max amount of time a query may run
+	// for.
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+
+	// Role specifies the database role to assume before executing a query.
+	Role string `yaml:"role"`
+
+	// MaxRows specifies the max number of rows a query may return, unless
+	// the task payload specifies a limit on its own. A value of zero means
+	// no limit.
+	MaxRows int `yaml:"max_rows"`
+
+	// DisableAdHoc specifies whether to refuse queries provided as part of
+	// task payloads, so that only named queries may be executed.
+	DisableAdHoc bool `yaml:"disable_adhoc"`
+}
+
+// GuardrailConfig provides the configuration for the mass deletion guardrail.
+type GuardrailConfig struct {
+	// MaxDeletions specifies the number of findings, which may always be
+	// deleted in a single run. When not set, the default is used.
+	MaxDeletions *int `yaml:"max_deletions"`
+
+	// MaxDeletionPercentage specifies the maximum percentage of existing
+	// findings, which may be deleted in a single run, once MaxDeletions is
+	// exceeded. When not set, the default is used.
+	MaxDeletionPercentage *float64 `yaml:"max_deletion_percentage"`
 }
 
 // ODGConfig represents the Open Delivery Gear configuration
@@ -62,6 +117,81 @@ type ODGConfig struct {
 	UserAgent string `yaml:"user_agent"`
 
 	Auth ODGAuthConfig `yaml:"auth"`
+
+	// Batch specifies the settings for submitting and deleting items in
+	// batches.
+	Batch ODGBatchConfig `yaml:"batch"`
+
+	// Retry specifies the settings for retrying failed API calls.
+	Retry ODGRetryConfig `yaml:"retry"`
+
+	// HTTP specifies the settings of the HTTP client used for making API
+	// calls.
+	HTTP ODGHTTPConfig `yaml:"http"`
+}
+
+// ODGHTTPConfig provides the configuration of the HTTP client used for making
+// calls to the Open Delivery Gear API.
+type ODGHTTPConfig struct {
+	// Timeout specifies the max amount of time a single API call may take.
+	Timeout time.Duration `yaml:"timeout"`
+
+	// DialTimeout specifies the max amount of time to wait for a
+	// connection to be established.
+	DialTimeout time.Duration `yaml:"dial_timeout"`
+
+	// TLSHandshakeTimeout specifies the max amount of time to wait for a
+	// TLS handshake.
+	TLSHandshakeTimeout time.Duration `yaml:"tls_handshake_timeout"`
+
+	// CABundle specifies the path to a PEM-encoded bundle of CA
+	// certificates, which are trusted in addition to the system ones.
+	CABundle string `yaml:"ca_bundle"`
+
+	// InsecureSkipVerify disables the verification of the server
+	// certificate. It should only be used for development.
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify"`
+
+	// ProxyURL specifies the URL of the proxy to use. When empty, the
+	// proxy is taken from the environment.
+	ProxyURL string `yaml:"proxy_url"`
+
+	// MaxIdleConns specifies the max number of idle connections, which
+	// are kept for re-use.
+	MaxIdleConns int `yaml:"max_idle_conns"`
+}
+
+// ODGRetryConfig provides the configuration for retrying failed API calls to
+// the Open Delivery Gear API.
+type ODGRetryConfig struct {
+	// MaxRetries specifies the max number of times an API call is
+	// retried. Set to a negative value in order to disable retries.
+	MaxRetries int `yaml:"max_retries"`
+
+	// MinBackoff specifies the amount of time to wait before the first
+	// retry.
+	MinBackoff time.Duration `yaml:"min_backoff"`
+
+	// MaxBackoff specifies the max amount of time to wait before a retry.
+	// It must not be less than MinBackoff. When not set, it defaults to
+	// the greater of MinBackoff and the default max backoff.
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+}
+
+// ODGBatchConfig provides the configuration for submitting and deleting items
+// in batches to the Open Delivery Gear API.
+type ODGBatchConfig struct {
+	// ArtefactMetadataSize specifies the number of artefact metadata items,
+	// which are submitted or deleted in a single API call.
+	ArtefactMetadataSize int `yaml:"artefact_metadata_size"`
+
+	// RuntimeArtefactsSize specifies the number of runtime artefacts, which
+	// are submitted or deleted in a single API call.
+	RuntimeArtefactsSize int `yaml:"runtime_artefacts_size"`
+
+	// Parallelism specifies the number of concurrent API calls, which are
+	// made when submitting or deleting items in batches.
+	Parallelism int `yaml:"parallelism"`
 }
 
 // ODGAuthConfig represents the Open Delivery Gear authentication configuration.
@@ -73,6 +203,27 @@ type ODGAuthConfig struct {
 	// Github specifies the settings for `github' authentication method when
 	// authenticating against the remote API.
 	Github ODGAuthGithubConfig `yaml:"github"`
+
+	// MTLS specifies the settings for `mtls' authentication method when
+	// authenticating against the remote API.
+	MTLS ODGAuthMTLSConfig `yaml:"mtls"`
+}
+
+// ODGAuthMTLSConfig provides the configuration for `mtls' authentication
+// method.
+type ODGAuthMTLSConfig struct {
+	// CertFile specifies the path to the PEM-encoded client certificate.
+	// The certificate is reloaded, when it is rotated on disk.
+	CertFile string `yaml:"cert_file"`
+
+	// KeyFile specifies the path to the PEM-encoded private key of the
+	// client certificate.
+	KeyFile string `yaml:"key_file"`
+
+	// CAFile specifies the path to a PEM-encoded bundle of CA
+	// certificates, which are trusted for verifying the certificate of
+	// the remote API, in addition to the system ones.
+	CAFile string `yaml:"ca_file"`
 }
 
 // ODGAuthGithubConfig provides the configuration for `github' authentication
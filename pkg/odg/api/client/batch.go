@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultArtefactMetadataBatchSize is the default number of artefact metadata
+// items, which are submitted or deleted in a single API call.
+const DefaultArtefactMetadataBatchSize = 500
+
+// DefaultRuntimeArtefactsBatchSize is the default number of runtime artefacts,
+// which are submitted or deleted in a single API call.
+//
+// The names of runtime artefacts to delete are passed as query parameters, so
+// this value should be kept low enough in order to avoid hitting URL length
+// limits.
+const DefaultRuntimeArtefactsBatchSize = 100
+
+// DefaultParallelism is the default number of concurrent API calls, which are
+// made when submitting or deleting items in batches.
+const DefaultParallelism = 4
+
+// BatchError represents an error, which occurred while processing a batch of
+// items.
+type BatchError struct {
+	// Offset specifies the offset of the first item of the batch.
+	Offset int
+
+	// Size specifies the number of items in the batch.
+	Size int
+
+	// Err is the error, which occurred while processing the batch.
+	Err error
+}
+
+// Error implements the error interface
+func (be *BatchError) Error() string {
+	return fmt.Sprintf("batch [%d:%d]: %s", be.Offset, be.Offset+be.Size, be.Err)
+}
+
+// Unwrap returns the underlying error
+func (be *BatchError) Unwrap() error {
+	return be.Err
+}
+
+// forEachBatch splits the given items into batches of the given size and
+// invokes fn for each batch, making at most parallelism concurrent calls.
+//
+// All batches are processed, regardless of failures in other batches, and the
+// returned error aggregates the [BatchError] for each failed batch.
+func forEachBatch[T any](ctx context.Context, items []T, size int, parallelism int, fn func(ctx context.Context, batch []T) error) error {
+	if size <= 0 {
+		size = len(items)
+	}
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	sem := make(chan struct{}, parallelism)
+	for offset := 0; offset < len(items); offset += size {
+		batch := items[offset:min(offset+size, len(items))]
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := fn(ctx, batch); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, &BatchError{Offset: offset, Size: len(batch), Err: err})
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachBatch(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		items       []int
+		size        int
+		parallelism int
+		want        [][]int
+	}{
+		{
+			desc:        "no items",
+			items:       []int{},
+			size:        2,
+			parallelism: 2,
+			want:        [][]int{},
+		},
+		{
+			desc:        "items fit into batches",
+			items:       []int{1, 2, 3, 4},
+			size:        2,
+			parallelism: 2,
+			want:        [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			desc:        "last batch is smaller",
+			items:       []int{1, 2, 3, 4, 5},
+			size:        2,
+			parallelism: 1,
+			want:        [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			desc:        "no batch size",
+			items:       []int{1, 2, 3},
+			size:        0,
+			parallelism: 0,
+			want:        [][]int{{1, 2, 3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var (
+				mu  sync.Mutex
+				got = make([][]int, 0)
+			)
+
+			err := forEachBatch(context.Background(), tc.items, tc.size, tc.parallelism, func(_ context.Context, batch []int) error {
+				mu.Lock()
+				defer mu.Unlock()
+				got = append(got, batch)
+
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			slices.SortFunc(got, func(a, b []int) int { return a[0] - b[0] })
+			if !slices.EqualFunc(got, tc.want, slices.Equal) {
+				t.Fatalf("want batches %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestForEachBatchErrors(t *testing.T) {
+	errBatch := errors.New("batch failed")
+	items := []int{1, 2, 3, 4, 5, 6}
+
+	var calls atomic.Int32
+	err := forEachBatch(context.Background(), items, 2, 2, func(_ context.Context, batch []int) error {
+		calls.Add(1)
+		if batch[0] == 3 || batch[0] == 5 {
+			return errBatch
+		}
+
+		return nil
+	})
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("want all 3 batches to be processed, got %d", got)
+	}
+
+	if !errors.Is(err, errBatch) {
+		t.Fatalf("want error %v, got %v", errBatch, err)
+	}
+
+	var offsets []int
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var batchErr *BatchError
+		if !errors.As(e, &batchErr) {
+			t.Fatalf("want *BatchError, got %T", e)
+		}
+		if batchErr.Size != 2 {
+			t.Fatalf("want batch size 2, got %d", batchErr.Size)
+		}
+		offsets = append(offsets, batchErr.Offset)
+	}
+
+	slices.Sort(offsets)
+	if want := []int{2, 4}; !slices.Equal(offsets, want) {
+		t.Fatalf("want failed batches at offsets %v, got %v", want, offsets)
+	}
+}
+
+func TestForEachBatchParallelism(t *testing.T) {
+	const parallelism = 2
+
+	var running, peak atomic.Int32
+	items := make([]int, 20)
+	err := forEachBatch(context.Background(), items, 1, parallelism, func(_ context.Context, _ []int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := peak.Load(); got > parallelism {
+		t.Fatalf("want at most %d concurrent calls, got %d", parallelism, got)
+	}
+}
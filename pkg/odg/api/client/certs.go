@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+)
+
+// certificateReloader provides the client certificate for mutual TLS
+// authentication, and reloads it when the certificate or key files change on
+// disk, e.g. when they are rotated.
+type certificateReloader struct {
+	// certFile specifies the path to the PEM-encoded certificate.
+	certFile string
+
+	// keyFile specifies the path to the PEM-encoded private key.
+	keyFile string
+
+	// mu guards cert, certModTime and keyModTime.
+	mu sync.Mutex
+
+	// cert is the currently loaded certificate.
+	cert *tls.Certificate
+
+	// certModTime is the modification time of the loaded certificate file.
+	certModTime time.Time
+
+	// keyModTime is the modification time of the loaded key file.
+	keyModTime time.Time
+}
+
+// newCertificateReloader creates a new [certificateReloader] for the given
+// certificate and key files, and loads the certificate.
+func newCertificateReloader(certFile, keyFile string) (*certificateReloader, error) {
+	r := &certificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// reload loads the certificate, if the certificate or key files have changed
+// since they were last loaded.
+//
+// The caller must hold the lock, unless the reloader is not in use yet.
+func (r *certificateReloader) reload() error {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return err
+	}
+
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	if r.cert != nil && certInfo.ModTime().Equal(r.certModTime) && keyInfo.ModTime().Equal(r.keyModTime) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.cert = &cert
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+
+	return nil
+}
+
+// GetClientCertificate implements the [tls.Config.GetClientCertificate]
+// callback.
+//
+// If reloading the changed certificate fails, e.g. because only one of the
+// files has been rotated yet, the previously loaded certificate is used, and
+// reloading is attempted again on the next TLS handshake.
+func (r *certificateReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_ = r.reload()
+
+	return r.cert, nil
+}
@@ -16,7 +16,6 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
 	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
@@ -33,6 +32,11 @@ const AuthCookie = "bearer_token"
 // not return an authentication cookie upon successful authentication.
 var ErrNoAuthCookie = errors.New("no authentication cookie returned")
 
+// ErrReauthenticationFailed is an error, which is returned when an API call has
+// been rejected due to an invalid token, and re-authenticating against the
+// remote API in order to replay the API call has failed.
+var ErrReauthenticationFailed = errors.New("re-authentication failed")
+
 // ErrNoGithubAPIURL is an error, which is returned when the [Client] is
 // attempting to authenticate, but no Github API URL has been configured.
 var ErrNoGithubAPIURL = errors.New("no github api url configured")
@@ -90,8 +94,6 @@ type Option func(c *Client) error
 // Client is an API client for interfacing with the Open Delivery Gear API
 // service.
 type Client struct {
-	mu sync.Mutex
-
 	// endpoint specifies the remote Delivery Service base API endpoint
 	endpoint *url.URL
 
@@ -112,10 +114,29 @@ type Client struct {
 	// signed with the Delivery Service private keys.
 	authGithubToken string
 
-	// tokenExpiresAt specifies the time when the token is considered
-	// expired. It is present only when using an authentication method,
-	// which returns an auth cookie.
-	tokenExpiresAt time.Time
+	// tokens manages the lifecycle of the authentication token. It is
+	// active only when using an authentication method, which returns an
+	// auth cookie.
+	tokens *tokenManager
+
+	// artefactMetadataBatchSize specifies the number of artefact metadata
+	// items, which are submitted or deleted in a single API call.
+	artefactMetadataBatchSize int
+
+	// runtimeArtefactsBatchSize specifies the number of runtime artefacts,
+	// which are submitted or deleted in a single API call.
+	runtimeArtefactsBatchSize int
+
+	// parallelism specifies the number of concurrent API calls to make,
+	// when submitting or deleting items in batches.
+	parallelism int
+
+	// retryPolicy specifies how failed API calls are retried.
+	retryPolicy RetryPolicy
+
+	// retryObserver is an optional function, which is called each time
+	// an API call is retried.
+	retryObserver RetryObserver
 }
 
 // New creates a new [Client] against the provided endpoint and configures it
@@ -127,8 +148,13 @@ func New(endpoint string, opts ...Option) (*Client, error) {
 	}
 
 	c := &Client{
-		endpoint: u,
+		endpoint:                  u,
+		artefactMetadataBatchSize: DefaultArtefactMetadataBatchSize,
+		runtimeArtefactsBatchSize: DefaultRuntimeArtefactsBatchSize,
+		parallelism:               DefaultParallelism,
+		retryPolicy:               DefaultRetryPolicy,
 	}
+	c.tokens = newTokenManager(c.authenticate)
 
 	for _, opt := range opts {
 		if err := opt(c); err != nil {
@@ -136,19 +162,28 @@ func New(endpoint string, opts ...Option) (*Client, error) {
 		}
 	}
 
-	// Configure default HTTP client, unless already set
+	// Configure a dedicated HTTP client with the default settings, unless
+	// already set
 	if c.httpClient == nil {
-		c.httpClient = http.DefaultClient
+		httpClient, err := NewHTTPClient(TransportConfig{})
+		if err != nil {
+			return nil, err
+		}
+		c.httpClient = httpClient
 	}
 
 	// Make sure that we've got a cookie jar, so that we can store and
-	// re-use the authentication cookie.
+	// re-use the authentication cookie. The jar is set on a copy of the
+	// HTTP client, so that the one provided by the caller is not
+	// modified.
 	if c.httpClient.Jar == nil {
 		jar, err := cookiejar.New(nil)
 		if err != nil {
 			return nil, err
 		}
-		c.httpClient.Jar = jar
+		httpClient := *c.httpClient
+		httpClient.Jar = jar
+		c.httpClient = &httpClient
 	}
 
 	return c, nil
@@ -165,25 +200,149 @@ func (c *Client) setReqHeaders(req *http.Request) {
 }
 
 // doRequest performs the HTTP API call from the provided request.
+//
+// Idempotent API calls are retried according to the configured
+// [RetryPolicy].
+//
+// When authentication is used and the API call is rejected with 401 or 403,
+// the token may have been invalidated before its expiry, e.g. because the
+// Delivery Service rotated its signing keys. In that case the client
+// re-authenticates once and replays the API call.
 func (c *Client) doRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
-	// When using authentication tokenExpiresAt will be set to a non-zero
-	// value. If we are approaching the token expiration we need to
-	// re-authenticate.
-	if !c.tokenExpiresAt.IsZero() {
-		c.mu.Lock()
-		defer c.mu.Unlock()
-
-		now := time.Now()
-		if now.After(c.tokenExpiresAt.Add(time.Duration(-1) * time.Minute)) {
-			if err := c.Authenticate(ctx); err != nil {
+	c.setReqHeaders(req)
+
+	// Make sure that the body can be re-created, so that the request can
+	// be retried and replayed.
+	if err := bufferBody(req); err != nil {
+		return nil, err
+	}
+
+	generation := c.tokens.getGeneration()
+	resp, err := c.doRequestWithRetry(ctx, req)
+	if err != nil || generation == 0 {
+		return resp, err
+	}
+
+	if resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden {
+		return resp, nil
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if err := c.tokens.renew(ctx, generation); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReauthenticationFailed, err)
+	}
+
+	return c.doRequestWithRetry(ctx, req)
+}
+
+// doRequestWithRetry performs the HTTP API call from the provided request, and
+// retries it according to the configured [RetryPolicy], if it is idempotent.
+//
+// The body of the request must be re-creatable via [http.Request.GetBody].
+func (c *Client) doRequestWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	retryable := isIdempotent(req)
+
+	for retry := 0; ; retry++ {
+		// If we are approaching the token expiration we need to
+		// re-authenticate. The token is usually refreshed in the
+		// background already, so this is a fallback, e.g. when the
+		// background refresh failed.
+		if err := c.tokens.ensure(ctx); err != nil {
+			return nil, err
+		}
+
+		// The [http.Client] adds the cookies from its jar to the
+		// request, so each attempt is a copy of the original request,
+		// in order not to send stale cookies when retrying.
+		attempt, err := rewindRequest(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(attempt)
+		if !retryable || retry >= c.retryPolicy.MaxRetries {
+			return resp, err
+		}
+
+		wait := c.retryPolicy.backoff(retry)
+		switch {
+		case err != nil:
+			if !isRetryableError(err) {
 				return nil, err
 			}
+		case isRetryableStatus(resp.StatusCode):
+			if d, ok := retryAfter(resp, time.Now()); ok {
+				if d > c.retryPolicy.MaxBackoff {
+					return resp, nil
+				}
+				wait = d
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if c.retryObserver != nil {
+			c.retryObserver(req.Method, c.endpointPath(req.URL))
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
 		}
 	}
+}
 
-	c.setReqHeaders(req)
+// bufferBody buffers the body of the given request in memory, unless it can
+// already be re-created via [http.Request.GetBody].
+func bufferBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = int64(len(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	req.Body, _ = req.GetBody()
+
+	return nil
+}
+
+// rewindRequest returns a copy of the given request with a re-created body, so
+// that it can be sent again.
+func rewindRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
+	r := req.Clone(ctx)
+	if req.GetBody == nil {
+		return r, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = body
 
-	return c.httpClient.Do(req)
+	return r, nil
+}
+
+// endpointPath returns the path of the given API URL, relative to the base API
+// endpoint.
+func (c *Client) endpointPath(u *url.URL) string {
+	path := strings.TrimPrefix(u.Path, strings.TrimSuffix(c.endpoint.Path, "/"))
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return path
 }
 
 // Authenticate authenticates the API client against the remote Delivery Service
@@ -191,64 +350,68 @@ func (c *Client) doRequest(ctx context.Context, req *http.Request) (*http.Respon
 //
 // Upon successful authentication the Delivery Service returns a cookie with a
 // JWT bearer token, which will be used in subsequent API calls to the service.
+//
+// Once authenticated, the token is refreshed in the background before it
+// expires, until [Client.Logout] is called.
 func (c *Client) Authenticate(ctx context.Context) error {
+	return c.tokens.refresh(ctx, -1)
+}
+
+// authenticate authenticates the API client against the remote Delivery Service
+// API, and returns the time when the token is considered expired.
+func (c *Client) authenticate(ctx context.Context) (time.Time, error) {
 	if c.authGithubURL == nil {
-		return ErrNoGithubAPIURL
+		return time.Time{}, ErrNoGithubAPIURL
 	}
 
 	if c.authGithubToken == "" {
-		return ErrNoGithubToken
+		return time.Time{}, ErrNoGithubToken
 	}
 
 	u, err := url.JoinPath(c.endpoint.String(), "/auth")
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 	query := req.URL.Query()
 	query.Add("api_url", c.authGithubURL.String())
 	query.Add("access_token", c.authGithubToken)
 	req.URL.RawQuery = query.Encode()
 
-	// `Authenticate' method does not use `doRequest', because `doRequest' may
-	// acquire a lock in order to re-authenticate and get a new token.
+	// `authenticate' method does not use `doRequest', because `doRequest'
+	// may itself re-authenticate in order to get a new token.
 	c.setReqHeaders(req)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 	defer resp.Body.Close() // nolint: errcheck
 
 	if resp.StatusCode != http.StatusOK {
-		return APIErrorFromResponse(resp)
+		return time.Time{}, APIErrorFromResponse(resp)
 	}
 
 	// Make sure that the API returned our authentication cookie
-	gotAuthCookie := false
 	for _, cookie := range resp.Cookies() {
 		if cookie.Name == AuthCookie {
-			c.tokenExpiresAt = time.Now().Add(time.Duration(cookie.MaxAge) * time.Second)
-			gotAuthCookie = true
-
-			break
+			return cookieExpiry(time.Now(), cookie), nil
 		}
 	}
 
-	if !gotAuthCookie {
-		return ErrNoAuthCookie
-	}
-
-	return nil
+	return time.Time{}, ErrNoAuthCookie
 }
 
 // Logout logs out from the remote API.
 //
-// This operation essentially deletes the [AuthCookie] from the cookie jar.
+// This operation essentially deletes the [AuthCookie] from the cookie jar, and
+// stops refreshing the token in the background.
 func (c *Client) Logout(ctx context.Context) error {
+	c.tokens.stop()
+
 	u, err := url.JoinPath(c.endpoint.String(), "/auth/logout")
 	if err != nil {
 		return err
@@ -279,13 +442,45 @@ func (c *Client) QueryArtefactMetadata(
 	ctx context.Context,
 	datatype apitypes.Datatype,
 	items ...apitypes.ComponentArtefactID) ([]apitypes.ArtefactMetadata, error) {
+	var result []apitypes.ArtefactMetadata
+	if err := c.queryArtefactMetadata(ctx, datatype, items, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// QueryRawArtefactMetadata queries the Delivery Service API for the artefacts
+// of the given datatype, similar to [Client.QueryArtefactMetadata]. The
+// returned items are preserved exactly as they have been returned by the API,
+// regardless of their datatype.
+func (c *Client) QueryRawArtefactMetadata(
+	ctx context.Context,
+	datatype apitypes.Datatype,
+	items ...apitypes.ComponentArtefactID) ([]apitypes.RawArtefactMetadata, error) {
+	var result []apitypes.RawArtefactMetadata
+	if err := c.queryArtefactMetadata(ctx, datatype, items, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// queryArtefactMetadata queries the Delivery Service API for the artefacts of
+// the given datatype, and decodes the response into the value pointed to by
+// result.
+func (c *Client) queryArtefactMetadata(
+	ctx context.Context,
+	datatype apitypes.Datatype,
+	items []apitypes.ComponentArtefactID,
+	result any) error {
 	if len(items) == 0 {
-		return nil, nil
+		return nil
 	}
 
 	u, err := url.JoinPath(c.endpoint.String(), "/artefacts/metadata/query")
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Prepare payload for querying artefacts
@@ -295,58 +490,97 @@ func (c *Client) QueryArtefactMetadata(
 
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	// Querying artefacts does not change any data, so it is safe to
+	// retry. The nil-valued header is not sent.
+	req.Header["Idempotency-Key"] = nil
+
 	query := req.URL.Query()
 	query.Add("type", string(datatype))
 	req.URL.RawQuery = query.Encode()
 
 	resp, err := c.doRequest(ctx, req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close() // nolint: errcheck
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, APIErrorFromResponse(resp)
+		return APIErrorFromResponse(resp)
 	}
 
 	// Parse response body and return results to caller
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
-	}
-
-	var result []apitypes.ArtefactMetadata
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, err
+		return err
 	}
 
-	return result, nil
+	return json.Unmarshal(respBody, result)
 }
 
 // DeleteArtefactMetadata deletes the given list of [apitypes.ArtefactMetadata]
 // from the Delivery Service database.
+//
+// The items are deleted in batches, and the returned error aggregates the
+// errors for each failed batch.
 func (c *Client) DeleteArtefactMetadata(ctx context.Context, items ...apitypes.ArtefactMetadata) error {
+	return forEachBatch(ctx, items, c.artefactMetadataBatchSize, c.parallelism, c.deleteArtefactMetadata)
+}
+
+// deleteArtefactMetadata deletes the given list of [apitypes.ArtefactMetadata]
+// from the Delivery Service database in a single API call.
+func (c *Client) deleteArtefactMetadata(ctx context.Context, items []apitypes.ArtefactMetadata) error {
 	if len(items) == 0 {
 		return nil
 	}
 
-	u, err := url.JoinPath(c.endpoint.String(), "/artefacts/metadata")
-	if err != nil {
-		return err
+	payload := apitypes.ArtefactMetadataGroup{
+		Entries: items,
 	}
 
-	payload := apitypes.ArtefactMetadataGroup{
+	return c.deleteArtefactMetadataGroup(ctx, payload)
+}
+
+// DeleteRawArtefactMetadata deletes the given list of
+// [apitypes.RawArtefactMetadata] items from the Delivery Service database,
+// exactly as they have been returned by [Client.QueryRawArtefactMetadata].
+//
+// The items are deleted in batches, and the returned error aggregates the
+// errors for each failed batch.
+func (c *Client) DeleteRawArtefactMetadata(ctx context.Context, items ...apitypes.RawArtefactMetadata) error {
+	return forEachBatch(ctx, items, c.artefactMetadataBatchSize, c.parallelism, c.deleteRawArtefactMetadata)
+}
+
+// deleteRawArtefactMetadata deletes the given list of
+// [apitypes.RawArtefactMetadata] from the Delivery Service database in a single
+// API call.
+func (c *Client) deleteRawArtefactMetadata(ctx context.Context, items []apitypes.RawArtefactMetadata) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	payload := apitypes.RawArtefactMetadataGroup{
 		Entries: items,
 	}
 
+	return c.deleteArtefactMetadataGroup(ctx, payload)
+}
+
+// deleteArtefactMetadataGroup deletes the given group of artefact metadata
+// items from the Delivery Service database.
+func (c *Client) deleteArtefactMetadataGroup(ctx context.Context, payload any) error {
+	u, err := url.JoinPath(c.endpoint.String(), "/artefacts/metadata")
+	if err != nil {
+		return err
+	}
+
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
@@ -375,19 +609,52 @@ func (c *Client) DeleteArtefactMetadata(ctx context.Context, items ...apitypes.A
 //
 // The provided artefacts are either created, if they don't already exist, or
 // are updated when they are already present in the Delivery Service database.
+//
+// The items are submitted in batches, and the returned error aggregates the
+// errors for each failed batch.
 func (c *Client) SubmitArtefactMetadata(ctx context.Context, items ...apitypes.ArtefactMetadata) error {
+	return forEachBatch(ctx, items, c.artefactMetadataBatchSize, c.parallelism, c.submitArtefactMetadata)
+}
+
+// submitArtefactMetadata submits the given [apitypes.ArtefactMetadata] items to
+// the Delivery Service API in a single API call.
+func (c *Client) submitArtefactMetadata(ctx context.Context, items []apitypes.ArtefactMetadata) error {
 	if len(items) == 0 {
 		return nil
 	}
 
+	payload := apitypes.ArtefactMetadataGroup{
+		Entries: items,
+	}
+
+	return c.putArtefactMetadata(ctx, payload)
+}
+
+// SubmitArtefactScanInfo submits the given [apitypes.ArtefactScanInfoMetadata]
+// items to the Delivery Service API in a single API call.
+//
+// The provided items are either created, if they don't already exist, or are
+// updated when they are already present in the Delivery Service database.
+func (c *Client) SubmitArtefactScanInfo(ctx context.Context, items ...apitypes.ArtefactScanInfoMetadata) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	payload := apitypes.ArtefactScanInfoMetadataGroup{
+		Entries: items,
+	}
+
+	return c.putArtefactMetadata(ctx, payload)
+}
+
+// putArtefactMetadata submits the given group of artefact metadata items to the
+// Delivery Service API.
+func (c *Client) putArtefactMetadata(ctx context.Context, payload any) error {
 	u, err := url.JoinPath(c.endpoint.String(), "/artefacts/metadata")
 	if err != nil {
 		return err
 	}
 
-	payload := apitypes.ArtefactMetadataGroup{
-		Entries: items,
-	}
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
@@ -458,7 +725,16 @@ func (c *Client) QueryRuntimeArtefacts(ctx context.Context, labels map[string]st
 
 // DeleteRuntimeArtefacts deletes the runtime artefacts with the specified names
 // from the Delivery Service API.
+//
+// The runtime artefacts are deleted in batches, and the returned error
+// aggregates the errors for each failed batch.
 func (c *Client) DeleteRuntimeArtefacts(ctx context.Context, names ...string) error {
+	return forEachBatch(ctx, names, c.runtimeArtefactsBatchSize, c.parallelism, c.deleteRuntimeArtefacts)
+}
+
+// deleteRuntimeArtefacts deletes the runtime artefacts with the specified names
+// from the Delivery Service API in a single API call.
+func (c *Client) deleteRuntimeArtefacts(ctx context.Context, names []string) error {
 	if len(names) == 0 {
 		return nil
 	}
@@ -494,7 +770,20 @@ func (c *Client) DeleteRuntimeArtefacts(ctx context.Context, names ...string) er
 
 // SubmitRuntimeArtefact submits the given [apitypes.ComponentArtefactID] items
 // to the Delivery Service API as runtime artefacts.
+//
+// The items are submitted in batches, and the returned error aggregates the
+// errors for each failed batch.
 func (c *Client) SubmitRuntimeArtefact(ctx context.Context, labels map[string]string, items ...apitypes.ComponentArtefactID) error {
+	submit := func(ctx context.Context, batch []apitypes.ComponentArtefactID) error {
+		return c.submitRuntimeArtefact(ctx, labels, batch)
+	}
+
+	return forEachBatch(ctx, items, c.runtimeArtefactsBatchSize, c.parallelism, submit)
+}
+
+// submitRuntimeArtefact submits the given [apitypes.ComponentArtefactID] items
+// to the Delivery Service API as runtime artefacts in a single API call.
+func (c *Client) submitRuntimeArtefact(ctx context.Context, labels map[string]string, items []apitypes.ComponentArtefactID) error {
 	if len(items) == 0 {
 		return nil
 	}
@@ -564,6 +853,8 @@ func WithGithubAuthentication(apiURL, accessToken string) Option {
 
 // WithHTTPClient configures the [Client] to use the specified [http.Client] for
 // making calls to the Delivery Service API.
+//
+// See [NewHTTPClient] for creating a dedicated [http.Client].
 func WithHTTPClient(httpClient *http.Client) Option {
 	opt := func(c *Client) error {
 		c.httpClient = httpClient
@@ -585,3 +876,85 @@ func WithUserAgent(userAgent string) Option {
 
 	return opt
 }
+
+// WithArtefactMetadataBatchSize configures the [Client] to submit and delete
+// artefact metadata in batches of the given size.
+func WithArtefactMetadataBatchSize(size int) Option {
+	opt := func(c *Client) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid artefact metadata batch size: %d", size)
+		}
+		c.artefactMetadataBatchSize = size
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithRuntimeArtefactsBatchSize configures the [Client] to submit and delete
+// runtime artefacts in batches of the given size.
+func WithRuntimeArtefactsBatchSize(size int) Option {
+	opt := func(c *Client) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid runtime artefacts batch size: %d", size)
+		}
+		c.runtimeArtefactsBatchSize = size
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithParallelism configures the [Client] to make at most the given number of
+// concurrent API calls, when submitting or deleting items in batches.
+func WithParallelism(n int) Option {
+	opt := func(c *Client) error {
+		if n <= 0 {
+			return fmt.Errorf("invalid parallelism: %d", n)
+		}
+		c.parallelism = n
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithRetryPolicy configures the [Client] to retry failed API calls according
+// to the given [RetryPolicy].
+func WithRetryPolicy(policy RetryPolicy) Option {
+	opt := func(c *Client) error {
+		if policy.MaxRetries < 0 {
+			return fmt.Errorf("invalid max retries: %d", policy.MaxRetries)
+		}
+		if policy.MinBackoff < 0 {
+			return fmt.Errorf("invalid min backoff: %s", policy.MinBackoff)
+		}
+		if policy.MaxBackoff < policy.MinBackoff {
+			return fmt.Errorf(
+				"invalid backoff: max backoff %s is less than min backoff %s",
+				policy.MaxBackoff,
+				policy.MinBackoff,
+			)
+		}
+		c.retryPolicy = policy
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithRetryObserver configures the [Client] to call the given [RetryObserver]
+// each time an API call is retried.
+func WithRetryObserver(observer RetryObserver) Option {
+	opt := func(c *Client) error {
+		c.retryObserver = observer
+
+		return nil
+	}
+
+	return opt
+}
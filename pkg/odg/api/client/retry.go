@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy specifies how failed API calls are retried.
+//
+// Only idempotent API calls are retried, and only when they fail with a
+// connection reset, or with one of the 429, 502, 503 and 504 status codes.
+type RetryPolicy struct {
+	// MaxRetries specifies the max number of times an API call is retried.
+	// A value of zero disables retries.
+	MaxRetries int
+
+	// MinBackoff specifies the amount of time to wait before the first
+	// retry. The backoff doubles with each subsequent retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff specifies the max amount of time to wait before a retry.
+	// API calls are not retried, when the remote API asks to wait for
+	// longer than that via the Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the [RetryPolicy] used by the [Client], unless
+// configured otherwise.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 30 * time.Second,
+}
+
+// RetryObserver is a function, which is called each time an API call is
+// retried with the method and the endpoint of the API call, e.g. for
+// collecting metrics.
+type RetryObserver func(method, endpoint string)
+
+// isIdempotent returns true, if the given request may be retried.
+//
+// Following the conventions of [http.Transport], requests with an
+// `Idempotency-Key' or `X-Idempotency-Key' header are considered idempotent,
+// even if their method is not. The header may have a nil value, in which case
+// it is not sent.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	if _, ok := req.Header["Idempotency-Key"]; ok {
+		return true
+	}
+	if _, ok := req.Header["X-Idempotency-Key"]; ok {
+		return true
+	}
+
+	return false
+}
+
+// isRetryableError returns true, if the given error returned by the
+// [http.Client] is caused by the connection being reset.
+func isRetryableError(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isRetryableStatus returns true, if the given status code indicates that the
+// API call may succeed when retried.
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the amount of time to wait before the given retry, starting
+// from zero.
+//
+// The backoff grows exponentially and is capped by the max backoff of the
+// policy. Jitter of up to half of the backoff is applied, so that concurrent
+// API calls are not retried at the same time.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := min(p.MinBackoff, p.MaxBackoff)
+	for i := 0; i < retry && d < p.MaxBackoff; i++ {
+		d = min(2*d, p.MaxBackoff)
+	}
+
+	if d <= 1 {
+		return max(d, 0)
+	}
+
+	half := d / 2
+
+	return half + rand.N(d-half)
+}
+
+// retryAfter returns the amount of time to wait as specified by the
+// Retry-After header of the given response, if any.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given amount of time, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		policy  RetryPolicy
+		retry   int
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			desc:    "first retry",
+			policy:  RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:   0,
+			wantMin: 500 * time.Millisecond,
+			wantMax: time.Second,
+		},
+		{
+			desc:    "third retry",
+			policy:  RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:   2,
+			wantMin: 2 * time.Second,
+			wantMax: 4 * time.Second,
+		},
+		{
+			desc:    "capped by max backoff",
+			policy:  RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second},
+			retry:   10,
+			wantMin: 2500 * time.Millisecond,
+			wantMax: 5 * time.Second,
+		},
+		{
+			desc:    "many retries do not overflow",
+			policy:  RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:   1000,
+			wantMin: 30 * time.Second,
+			wantMax: time.Minute,
+		},
+		{
+			desc:    "min backoff above max backoff",
+			policy:  RetryPolicy{MinBackoff: time.Minute, MaxBackoff: time.Second},
+			retry:   0,
+			wantMin: 500 * time.Millisecond,
+			wantMax: time.Second,
+		},
+		{
+			desc:    "no backoff",
+			policy:  RetryPolicy{},
+			retry:   3,
+			wantMin: 0,
+			wantMax: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for range 100 {
+				got := tc.policy.backoff(tc.retry)
+				if got < tc.wantMin || got > tc.wantMax {
+					t.Fatalf("want backoff in [%s, %s], got %s", tc.wantMin, tc.wantMax, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		desc   string
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{
+			desc:   "no header",
+			value:  "",
+			want:   0,
+			wantOk: false,
+		},
+		{
+			desc:   "seconds",
+			value:  "120",
+			want:   2 * time.Minute,
+			wantOk: true,
+		},
+		{
+			desc:   "negative seconds",
+			value:  "-5",
+			want:   0,
+			wantOk: true,
+		},
+		{
+			desc:   "http date",
+			value:  now.Add(90 * time.Second).Format(http.TimeFormat),
+			want:   90 * time.Second,
+			wantOk: true,
+		},
+		{
+			desc:   "http date in the past",
+			value:  now.Add(-time.Minute).Format(http.TimeFormat),
+			want:   0,
+			wantOk: true,
+		},
+		{
+			desc:   "invalid value",
+			value:  "soon",
+			want:   0,
+			wantOk: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tc.value != "" {
+				resp.Header.Set("Retry-After", tc.value)
+			}
+
+			got, ok := retryAfter(resp, now)
+			if ok != tc.wantOk || got != tc.want {
+				t.Fatalf("want %s (%t), got %s (%t)", tc.want, tc.wantOk, got, ok)
+			}
+		})
+	}
+}
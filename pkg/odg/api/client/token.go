@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTokenLifetime is the lifetime assumed for an authentication token,
+// when its expiry cannot be determined from the [AuthCookie].
+const DefaultTokenLifetime = 10 * time.Minute
+
+// tokenExpiryLeeway specifies how long before its expiry a token is refreshed
+// synchronously by API calls.
+const tokenExpiryLeeway = time.Minute
+
+// tokenRefreshAhead specifies how long before its expiry a token is refreshed
+// in the background.
+const tokenRefreshAhead = 5 * time.Minute
+
+// minTokenRefreshDelay specifies the min amount of time between refreshing the
+// token in the background, so that tokens with a very short lifetime do not
+// result in re-authenticating in a tight loop.
+const minTokenRefreshDelay = 10 * time.Second
+
+// tokenRefreshTimeout specifies the max amount of time a background refresh of
+// the token may take.
+const tokenRefreshTimeout = 30 * time.Second
+
+// tokenManager manages the lifecycle of the authentication token.
+//
+// API calls only need to check the expiry of the token, which does not require
+// holding a lock. The lock is held only while the token is being refreshed,
+// so that concurrent API calls approaching the token expiry result in a single
+// re-authentication.
+type tokenManager struct {
+	// mu serializes refreshing the token.
+	mu sync.Mutex
+
+	// expiresAtMu guards expiresAt, generation, timer and stopped.
+	expiresAtMu sync.RWMutex
+
+	// expiresAt specifies the time when the token is considered expired.
+	// It is zero until the token has been fetched for the first time.
+	expiresAt time.Time
+
+	// generation is incremented each time a new token is fetched. It is
+	// zero until the token has been fetched for the first time.
+	generation uint64
+
+	// timer refreshes the token in the background before it expires.
+	timer *time.Timer
+
+	// stopped specifies whether refreshing the token in the background
+	// has been stopped.
+	stopped bool
+
+	// fetch fetches a new token and returns its expiry.
+	fetch func(ctx context.Context) (time.Time, error)
+}
+
+// newTokenManager creates a new [tokenManager], which uses the given function
+// to fetch new tokens.
+func newTokenManager(fetch func(ctx context.Context) (time.Time, error)) *tokenManager {
+	m := &tokenManager{
+		fetch: fetch,
+	}
+
+	return m
+}
+
+// getExpiresAt returns the time when the token is considered expired.
+func (m *tokenManager) getExpiresAt() time.Time {
+	m.expiresAtMu.RLock()
+	defer m.expiresAtMu.RUnlock()
+
+	return m.expiresAt
+}
+
+// getGeneration returns the generation of the current token.
+func (m *tokenManager) getGeneration() uint64 {
+	m.expiresAtMu.RLock()
+	defer m.expiresAtMu.RUnlock()
+
+	return m.generation
+}
+
+// needsRefresh returns true, if the token expires within the given duration.
+func (m *tokenManager) needsRefresh(within time.Duration) bool {
+	return time.Now().After(m.getExpiresAt().Add(-within))
+}
+
+// ensure makes sure that the token does not expire soon, refreshing it if
+// needed. It is a no-op until the token has been fetched for the first time.
+func (m *tokenManager) ensure(ctx context.Context) error {
+	if m.getExpiresAt().IsZero() || !m.needsRefresh(tokenExpiryLeeway) {
+		return nil
+	}
+
+	return m.refresh(ctx, tokenExpiryLeeway)
+}
+
+// refresh fetches a new token, unless the current one does not expire within
+// the given duration, e.g. because it has already been refreshed by a
+// concurrent caller. A negative duration always fetches a new token.
+func (m *tokenManager) refresh(ctx context.Context, within time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if within >= 0 && !m.getExpiresAt().IsZero() && !m.needsRefresh(within) {
+		return nil
+	}
+
+	return m.fetchLocked(ctx, within < 0)
+}
+
+// fetchLocked fetches a new token and schedules refreshing it in the
+// background. When restart is true, refreshing the token in the background is
+// resumed, if it has been stopped. The caller must hold the refresh lock.
+func (m *tokenManager) fetchLocked(ctx context.Context, restart bool) error {
+	expiresAt, err := m.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.expiresAtMu.Lock()
+	defer m.expiresAtMu.Unlock()
+
+	m.expiresAt = expiresAt
+	m.generation++
+	if restart {
+		m.stopped = false
+	}
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+	// Tokens, which have already expired, are not refreshed in the
+	// background, since refreshing them would most likely yield an
+	// expired token again. API calls refresh them on their own.
+	now := time.Now()
+	if !m.stopped && expiresAt.After(now) {
+		m.timer = time.AfterFunc(refreshDelay(now, expiresAt), m.refreshInBackground)
+	}
+
+	return nil
+}
+
+// renew fetches a new token in place of the token with the given generation,
+// which has been rejected by the remote API. No new token is fetched, if the
+// rejected token has already been replaced, e.g. by a concurrent caller.
+func (m *tokenManager) renew(ctx context.Context, generation uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.getGeneration() != generation {
+		return nil
+	}
+
+	return m.fetchLocked(ctx, false)
+}
+
+// refreshInBackground refreshes the token in the background.
+//
+// Errors are not reported, since API calls refresh the token on their own,
+// when it is about to expire.
+func (m *tokenManager) refreshInBackground() {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenRefreshTimeout)
+	defer cancel()
+
+	_ = m.refresh(ctx, tokenRefreshAhead)
+}
+
+// stop stops refreshing the token in the background, until a new token is
+// explicitly fetched.
+func (m *tokenManager) stop() {
+	m.expiresAtMu.Lock()
+	defer m.expiresAtMu.Unlock()
+
+	m.stopped = true
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+}
+
+// refreshDelay returns the duration after which a token with the given expiry
+// is refreshed in the background.
+//
+// Tokens are refreshed [tokenRefreshAhead] before they expire, or half-way
+// through their remaining lifetime for short-lived tokens, but not sooner than
+// [minTokenRefreshDelay].
+func refreshDelay(now, expiresAt time.Time) time.Duration {
+	remaining := expiresAt.Sub(now)
+	if remaining <= 2*tokenRefreshAhead {
+		return max(remaining/2, minTokenRefreshDelay)
+	}
+
+	return remaining - tokenRefreshAhead
+}
+
+// cookieExpiry returns the time when the token from the given [AuthCookie]
+// expires.
+//
+// The expiry is taken from the Max-Age attribute of the cookie, the exp claim
+// of the JWT token, or the Expires attribute of the cookie, in that order. If
+// none of them is present, the token is assumed to expire after
+// [DefaultTokenLifetime].
+func cookieExpiry(now time.Time, cookie *http.Cookie) time.Time {
+	if cookie.MaxAge > 0 {
+		return now.Add(time.Duration(cookie.MaxAge) * time.Second)
+	}
+
+	if exp, ok := jwtExpiry(cookie.Value); ok {
+		return exp
+	}
+
+	if !cookie.Expires.IsZero() {
+		return cookie.Expires
+	}
+
+	return now.Add(DefaultTokenLifetime)
+}
+
+// jwtExpiry returns the time specified by the exp claim of the given JWT token.
+// The signature of the token is not verified.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(data, &claims); err != nil || claims.Exp <= 0 {
+		return time.Time{}, false
+	}
+
+	sec := int64(claims.Exp)
+	nsec := int64((claims.Exp - float64(sec)) * float64(time.Second))
+
+	return time.Unix(sec, nsec), true
+}
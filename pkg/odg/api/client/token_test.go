@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRefreshDelay(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		desc      string
+		expiresIn time.Duration
+		want      time.Duration
+	}{
+		{
+			desc:      "long-lived token",
+			expiresIn: time.Hour,
+			want:      time.Hour - tokenRefreshAhead,
+		},
+		{
+			desc:      "token expiring right after the refresh window",
+			expiresIn: 2*tokenRefreshAhead + time.Second,
+			want:      tokenRefreshAhead + time.Second,
+		},
+		{
+			desc:      "short-lived token",
+			expiresIn: 4 * time.Minute,
+			want:      2 * time.Minute,
+		},
+		{
+			desc:      "very short-lived token",
+			expiresIn: 5 * time.Second,
+			want:      minTokenRefreshDelay,
+		},
+		{
+			desc:      "expired token",
+			expiresIn: -time.Minute,
+			want:      minTokenRefreshDelay,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := refreshDelay(now, now.Add(tc.expiresIn))
+			if got != tc.want {
+				t.Fatalf("want delay %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
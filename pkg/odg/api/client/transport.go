@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// ErrInvalidCABundle is an error, which is returned when the configured CA
+// bundle does not contain any PEM-encoded certificates.
+var ErrInvalidCABundle = errors.New("invalid ca bundle")
+
+// ErrIncompleteClientCertificate is an error, which is returned when only one of
+// the client certificate and key files has been configured.
+var ErrIncompleteClientCertificate = errors.New("both client certificate and key must be specified")
+
+const (
+	// DefaultRequestTimeout is the default max amount of time a single
+	// API call may take, including reading the response body.
+	DefaultRequestTimeout = 2 * time.Minute
+
+	// DefaultDialTimeout is the default max amount of time to wait for a
+	// connection to the remote API to be established.
+	DefaultDialTimeout = 30 * time.Second
+
+	// DefaultTLSHandshakeTimeout is the default max amount of time to wait
+	// for a TLS handshake with the remote API.
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+
+	// DefaultMaxIdleConns is the default max number of idle connections
+	// to the remote API, which are kept for re-use.
+	DefaultMaxIdleConns = 100
+)
+
+// TransportConfig specifies the settings of the [http.Client] used for making
+// calls to the Delivery Service API. Zero values result in the respective
+// defaults.
+type TransportConfig struct {
+	// Timeout specifies the max amount of time a single API call may take.
+	Timeout time.Duration
+
+	// DialTimeout specifies the max amount of time to wait for a
+	// connection to be established.
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout specifies the max amount of time to wait for a
+	// TLS handshake.
+	TLSHandshakeTimeout time.Duration
+
+	// CABundle specifies the path to a PEM-encoded bundle of CA
+	// certificates, which are trusted in addition to the system ones.
+	CABundle string
+
+	// InsecureSkipVerify disables the verification of the certificate
+	// presented by the remote API. It should only be used for development.
+	InsecureSkipVerify bool
+
+	// ProxyURL specifies the URL of the proxy to use. When empty, the proxy
+	// is taken from the environment, e.g. HTTPS_PROXY.
+	ProxyURL string
+
+	// MaxIdleConns specifies the max number of idle connections, which are
+	// kept for re-use.
+	MaxIdleConns int
+
+	// CertFile specifies the path to a PEM-encoded client certificate,
+	// which is presented to the remote API for mutual TLS authentication.
+	// The certificate is reloaded, when it changes on disk.
+	CertFile string
+
+	// KeyFile specifies the path to the PEM-encoded private key of the
+	// client certificate.
+	KeyFile string
+}
+
+// NewHTTPClient creates a new dedicated [http.Client] for making calls to the
+// Delivery Service API, which is configured using the given [TransportConfig].
+//
+// The returned client does not share any state with [http.DefaultClient].
+func NewHTTPClient(conf TransportConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if conf.ProxyURL != "" {
+		u, err := url.Parse(conf.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   valueOrDefault(conf.DialTimeout, DefaultDialTimeout),
+		KeepAlive: 30 * time.Second,
+	}
+
+	// All API calls go to the same host, so idle connections are not
+	// limited per host any further.
+	maxIdleConns := valueOrDefault(conf.MaxIdleConns, DefaultMaxIdleConns)
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   valueOrDefault(conf.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConns,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   valueOrDefault(conf.Timeout, DefaultRequestTimeout),
+	}
+
+	return client, nil
+}
+
+// newTLSConfig creates the [tls.Config] for the given [TransportConfig].
+func newTLSConfig(conf TransportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conf.InsecureSkipVerify, // nolint: gosec
+	}
+
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		if conf.CertFile == "" || conf.KeyFile == "" {
+			return nil, ErrIncompleteClientCertificate
+		}
+
+		reloader, err := newCertificateReloader(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client certificate: %w", err)
+		}
+		tlsConfig.GetClientCertificate = reloader.GetClientCertificate
+	}
+
+	if conf.CABundle == "" {
+		return tlsConfig, nil
+	}
+
+	data, err := os.ReadFile(conf.CABundle)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCABundle, conf.CABundle)
+	}
+	tlsConfig.RootCAs = pool
+
+	return tlsConfig, nil
+}
+
+// valueOrDefault returns the given value, or the default value if the given
+// value is not positive.
+func valueOrDefault[T int | time.Duration](value, defaultValue T) T {
+	if value > 0 {
+		return value
+	}
+
+	return defaultValue
+}
@@ -12,6 +12,8 @@
 package types
 
 import (
+	"encoding/json"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/civil"
@@ -91,6 +93,107 @@ const (
 
 	// ResourceKindIPAddressGCP represents a GCP Public IP address resource.
 	ResourceKindIPAddressGCP ResourceKind = "gcp/public-ip-address"
+
+	// ResourceKindIPAddressAWS represents an AWS Elastic IP address resource.
+	ResourceKindIPAddressAWS ResourceKind = "aws/public-ip-address"
+
+	// ResourceKindIPAddressAzure represents an Azure Public IP address
+	// resource.
+	ResourceKindIPAddressAzure ResourceKind = "az/public-ip-address"
+
+	// ResourceKindIPAddressOpenStack represents an OpenStack Floating IP
+	// address resource.
+	ResourceKindIPAddressOpenStack ResourceKind = "openstack/public-ip-address"
+
+	// ResourceKindVolumeAWS represents an AWS EBS volume resource.
+	ResourceKindVolumeAWS ResourceKind = "aws/volume"
+
+	// ResourceKindDiskGCP represents a GCP persistent disk resource.
+	ResourceKindDiskGCP ResourceKind = "gcp/disk"
+
+	// ResourceKindDiskAzure represents an Azure managed disk resource.
+	ResourceKindDiskAzure ResourceKind = "az/disk"
+
+	// ResourceKindLoadBalancerAWS represents an AWS Elastic Load Balancer
+	// resource.
+	ResourceKindLoadBalancerAWS ResourceKind = "aws/load-balancer"
+
+	// ResourceKindLoadBalancerAzure represents an Azure Load Balancer
+	// resource.
+	ResourceKindLoadBalancerAzure ResourceKind = "az/load-balancer"
+
+	// ResourceKindLoadBalancerOpenStack represents an OpenStack Octavia Load
+	// Balancer resource.
+	ResourceKindLoadBalancerOpenStack ResourceKind = "openstack/load-balancer"
+
+	// ResourceKindBucketAWS represents an AWS S3 bucket resource.
+	ResourceKindBucketAWS ResourceKind = "aws/bucket"
+
+	// ResourceKindBucketGCP represents a GCP Cloud Storage bucket resource.
+	ResourceKindBucketGCP ResourceKind = "gcp/bucket"
+
+	// ResourceKindBucketAzure represents an Azure blob container resource.
+	ResourceKindBucketAzure ResourceKind = "az/blob-container"
+
+	// ResourceKindBucketOpenStack represents an OpenStack Swift container
+	// resource.
+	ResourceKindBucketOpenStack ResourceKind = "openstack/container"
+
+	// ResourceKindVPCAWS represents an AWS VPC resource.
+	ResourceKindVPCAWS ResourceKind = "aws/vpc"
+
+	// ResourceKindSubnetAWS represents an AWS subnet resource.
+	ResourceKindSubnetAWS ResourceKind = "aws/subnet"
+
+	// ResourceKindSecurityGroupAWS represents an AWS security group
+	// resource.
+	ResourceKindSecurityGroupAWS ResourceKind = "aws/security-group"
+
+	// ResourceKindNetworkGCP represents a GCP VPC network resource.
+	ResourceKindNetworkGCP ResourceKind = "gcp/network"
+
+	// ResourceKindSubnetGCP represents a GCP subnetwork resource.
+	ResourceKindSubnetGCP ResourceKind = "gcp/subnet"
+
+	// ResourceKindFirewallRuleGCP represents a GCP firewall rule resource.
+	ResourceKindFirewallRuleGCP ResourceKind = "gcp/firewall-rule"
+
+	// ResourceKindImageAWS represents an AWS AMI resource.
+	ResourceKindImageAWS ResourceKind = "aws/image"
+
+	// ResourceKindImageGCP represents a GCP Compute Engine image resource.
+	ResourceKindImageGCP ResourceKind = "gcp/image"
+
+	// ResourceKindImageAzure represents an Azure image resource.
+	ResourceKindImageAzure ResourceKind = "az/image"
+
+	// ResourceKindSnapshotAWS represents an AWS EBS snapshot resource.
+	ResourceKindSnapshotAWS ResourceKind = "aws/snapshot"
+
+	// ResourceKindSnapshotGCP represents a GCP disk snapshot resource.
+	ResourceKindSnapshotGCP ResourceKind = "gcp/snapshot"
+
+	// ResourceKindSnapshotAzure represents an Azure disk snapshot resource.
+	ResourceKindSnapshotAzure ResourceKind = "az/snapshot"
+
+	// ResourceKindDNSRecordAWS represents an AWS Route53 DNS record
+	// resource.
+	ResourceKindDNSRecordAWS ResourceKind = "aws/dns-record"
+
+	// ResourceKindDNSRecordGCP represents a GCP Cloud DNS record resource.
+	ResourceKindDNSRecordGCP ResourceKind = "gcp/dns-record"
+
+	// ResourceKindDNSRecordAzure represents an Azure DNS record resource.
+	ResourceKindDNSRecordAzure ResourceKind = "az/dns-record"
+
+	// ResourceKindMachineGardener represents a Gardener Machine resource,
+	// which has no backing virtual machine in the cloud provider.
+	ResourceKindMachineGardener ResourceKind = "gardener/machine"
+
+	// ResourceKindShootVirtualMachineGardener represents a virtual machine
+	// in the cloud provider, which belongs to a Gardener shoot, which no
+	// longer exists.
+	ResourceKindShootVirtualMachineGardener ResourceKind = "gardener/shoot-virtual-machine"
 )
 
 // ProviderName specifies the name of the provider, from which orphan resources
@@ -109,6 +212,11 @@ const (
 
 	// ProviderNameOpenStack represents OpenStack as the origin of orphan resources.
 	ProviderNameOpenStack ProviderName = "openstack"
+
+	// ProviderNameGardener represents Gardener as the origin of orphan
+	// resources, which are inconsistent between Gardener and the cloud
+	// providers.
+	ProviderNameGardener ProviderName = "gardener"
 )
 
 // Finding is a representation of the [InventoryFinding class]
@@ -179,6 +287,99 @@ type ArtefactMetadata struct {
 	DiscoveryDate civil.Date          `json:"discovery_date"`
 }
 
+// ArtefactScanInfo is a representation of the upstream [ArtefactScanInfo class].
+//
+// [ArtefactScanInfo class]: https://github.com/gardener/cc-utils/blob/af54ca4f80b6b96dbb981d7c9ea080239f552a49/dso/model.py#L536-L538
+type ArtefactScanInfo struct {
+	// ReportURL specifies an optional URL to the report of the scan.
+	ReportURL string `json:"report_url,omitempty"`
+
+	// Attributes specifies an optional set of attributes, which describe
+	// the outcome of the scan. Attributes are not part of the upstream
+	// class, and are nested under a single key, so that they do not clash
+	// with upstream fields.
+	Attributes *ArtefactScanAttributes `json:"attributes,omitempty"`
+}
+
+// ArtefactScanAttributes describes the outcome of a scan for orphan resources.
+type ArtefactScanAttributes struct {
+	// ProviderName specifies the name of the provider, which was scanned
+	// for orphan resources.
+	ProviderName ProviderName `json:"provider_name"`
+
+	// ResourceKind specifies the kind of orphan resources, which was
+	// scanned for.
+	ResourceKind ResourceKind `json:"resource_kind"`
+
+	// Discovered specifies the number of discovered orphan resources.
+	Discovered int `json:"discovered"`
+
+	// FindingsCreated specifies the number of created findings.
+	FindingsCreated int `json:"findings_created"`
+
+	// FindingsUpdated specifies the number of updated findings.
+	FindingsUpdated int `json:"findings_updated"`
+
+	// FindingsDeleted specifies the number of deleted findings.
+	FindingsDeleted int `json:"findings_deleted"`
+}
+
+// ArtefactScanInfoMetadata represents an [ArtefactMetadata] item, which carries
+// an [ArtefactScanInfo] as its data.
+type ArtefactScanInfoMetadata struct {
+	Artefact      ComponentArtefactID `json:"artefact"`
+	Meta          Metadata            `json:"meta"`
+	Data          ArtefactScanInfo    `json:"data"`
+	DiscoveryDate civil.Date          `json:"discovery_date"`
+}
+
+// ArtefactScanInfoMetadataGroup represents a group of
+// [ArtefactScanInfoMetadata] items.
+type ArtefactScanInfoMetadataGroup struct {
+	// Entries contains the group of [ArtefactScanInfoMetadata] items.
+	Entries []ArtefactScanInfoMetadata `json:"entries"`
+}
+
+// RawArtefactMetadata represents an artefact metadata item of any datatype,
+// which is preserved exactly as it has been returned by the Delivery Service
+// API, so that it can be passed back to the API, e.g. in order to delete it.
+type RawArtefactMetadata struct {
+	// Artefact specifies the artefact, which the item is associated with.
+	Artefact ComponentArtefactID
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (m *RawArtefactMetadata) UnmarshalJSON(data []byte) error {
+	var item struct {
+		Artefact ComponentArtefactID `json:"artefact"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+
+	m.Artefact = item.Artefact
+	m.raw = slices.Clone(data)
+
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (m RawArtefactMetadata) MarshalJSON() ([]byte, error) {
+	if m.raw == nil {
+		return []byte("null"), nil
+	}
+
+	return m.raw, nil
+}
+
+// RawArtefactMetadataGroup represents a group of [RawArtefactMetadata] items.
+type RawArtefactMetadataGroup struct {
+	// Entries contains the group of [RawArtefactMetadata] items.
+	Entries []RawArtefactMetadata `json:"entries"`
+}
+
 // ArtefactMetadataGroup represents a group of [ArtefactMetadata] items.
 type ArtefactMetadataGroup struct {
 	// Entries contains the group of [ArtefactMetadata] items.
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRawArtefactMetadata(t *testing.T) {
+	data := `{"artefact":{"component_name":"c","artefact":{"artefact_name":"n","artefact_type":"aws/virtual-machine"}},"meta":{"type":"meta/artefact_scan_info","responsibles":[]},"data":{"unknown":1}}`
+
+	var item RawArtefactMetadata
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatalf("cannot unmarshal item: %s", err)
+	}
+
+	if item.Artefact.Artefact.ArtefactName != "n" {
+		t.Fatalf("want artefact name n, got %q", item.Artefact.Artefact.ArtefactName)
+	}
+
+	got, err := json.Marshal(RawArtefactMetadataGroup{Entries: []RawArtefactMetadata{item}})
+	if err != nil {
+		t.Fatalf("cannot marshal item: %s", err)
+	}
+
+	want := `{"entries":[` + data + `]}`
+	if string(got) != want {
+		t.Fatalf("want %s, got %s", want, got)
+	}
+}
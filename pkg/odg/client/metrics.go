@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"sync"
+
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// apiRetriesDesc is the descriptor for a metric, which tracks the
+	// number of retried API calls to the Open Delivery Gear API.
+	apiRetriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "odg_api_retries"),
+		"A counter which tracks the number of retried API calls to ODG",
+		[]string{"method", "endpoint"},
+		nil,
+	)
+
+	// apiRetriesMu guards apiRetries.
+	apiRetriesMu sync.Mutex
+
+	// apiRetries is the number of retried API calls, keyed by method and
+	// endpoint.
+	apiRetries = make(map[[2]string]int)
+)
+
+// ObserveRetry records that an API call with the given method to the given
+// endpoint has been retried.
+//
+// ObserveRetry is an [odgapi.RetryObserver].
+func ObserveRetry(method, endpoint string) {
+	apiRetriesMu.Lock()
+	defer apiRetriesMu.Unlock()
+
+	key := [2]string{method, endpoint}
+	apiRetries[key]++
+
+	metrics.DefaultCollector.AddMetric(
+		metrics.Key("odg_api_retries", method, endpoint),
+		prometheus.MustNewConstMetric(
+			apiRetriesDesc,
+			prometheus.CounterValue,
+			float64(apiRetries[key]),
+			method,
+			endpoint,
+		),
+	)
+}
+
+// init registers the metric descriptors with [metrics.DefaultCollector]
+func init() {
+	metrics.DefaultCollector.AddDesc(apiRetriesDesc)
+}
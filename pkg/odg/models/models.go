@@ -105,3 +105,439 @@ type OrphanPublicAddressGCP struct {
 	Subnetwork          string `bun:"subnetwork" json:"subnetwork"`
 	Target              string `bun:"target" json:"target"`
 }
+
+// OrphanVolumeAWS represents an AWS EBS volume, which has been identified as
+// being orphan.
+type OrphanVolumeAWS struct {
+	VolumeID         string    `bun:"volume_id" json:"volume_id"`
+	Name             string    `bun:"name" json:"name"`
+	RegionName       string    `bun:"region_name" json:"region_name"`
+	AccountID        string    `bun:"account_id" json:"account_id"`
+	AvailabilityZone string    `bun:"availability_zone" json:"availability_zone"`
+	VolumeType       string    `bun:"volume_type" json:"volume_type"`
+	Size             int32     `bun:"size" json:"size"`
+	State            string    `bun:"state" json:"state"`
+	Encrypted        bool      `bun:"encrypted" json:"encrypted"`
+	SnapshotID       string    `bun:"snapshot_id" json:"snapshot_id"`
+	CreateTime       time.Time `bun:"create_time" json:"create_time"`
+}
+
+// OrphanDiskGCP represents a GCP persistent disk, which has been identified as
+// being orphan.
+type OrphanDiskGCP struct {
+	Name                string `bun:"name" json:"name"`
+	ProjectID           string `bun:"project_id" json:"project_id"`
+	Zone                string `bun:"zone" json:"zone"`
+	Region              string `bun:"region" json:"region"`
+	Type                string `bun:"type" json:"type"`
+	SizeGB              int64  `bun:"size_gb" json:"size_gb"`
+	Status              string `bun:"status" json:"status"`
+	Description         string `bun:"description" json:"description"`
+	IsRegional          bool   `bun:"is_regional" json:"is_regional"`
+	CreationTimestamp   string `bun:"creation_timestamp" json:"creation_timestamp"`
+	LastAttachTimestamp string `bun:"last_attach_timestamp" json:"last_attach_timestamp"`
+	LastDetachTimestamp string `bun:"last_detach_timestamp" json:"last_detach_timestamp"`
+}
+
+// OrphanDiskAzure represents an Azure managed disk, which has been identified
+// as being orphan.
+type OrphanDiskAzure struct {
+	Name              string    `bun:"name" json:"name"`
+	SubscriptionID    string    `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup     string    `bun:"resource_group" json:"resource_group"`
+	Location          string    `bun:"location" json:"location"`
+	DiskState         string    `bun:"disk_state" json:"disk_state"`
+	DiskSizeGB        int32     `bun:"disk_size_gb" json:"disk_size_gb"`
+	SkuName           string    `bun:"sku_name" json:"sku_name"`
+	ProvisioningState string    `bun:"provisioning_state" json:"provisioning_state"`
+	TimeCreated       time.Time `bun:"time_created" json:"time_created"`
+}
+
+// OrphanPublicAddressAWS represents an AWS Elastic IP address, which has been
+// identified as being orphan.
+type OrphanPublicAddressAWS struct {
+	AllocationID       string `bun:"allocation_id" json:"allocation_id"`
+	PublicIP           net.IP `bun:"public_ip" json:"public_ip"`
+	PrivateIPAddress   net.IP `bun:"private_ip_address" json:"private_ip_address"`
+	Domain             string `bun:"domain" json:"domain"`
+	AssociationID      string `bun:"association_id" json:"association_id"`
+	InstanceID         string `bun:"instance_id" json:"instance_id"`
+	NetworkInterfaceID string `bun:"network_interface_id" json:"network_interface_id"`
+	PublicIPv4Pool     string `bun:"public_ipv4_pool" json:"public_ipv4_pool"`
+	NetworkBorderGroup string `bun:"network_border_group" json:"network_border_group"`
+	RegionName         string `bun:"region_name" json:"region_name"`
+	AccountID          string `bun:"account_id" json:"account_id"`
+}
+
+// OrphanPublicAddressAzure represents an Azure public IP address, which has
+// been identified as being orphan.
+type OrphanPublicAddressAzure struct {
+	Name              string `bun:"name" json:"name"`
+	SubscriptionID    string `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup     string `bun:"resource_group" json:"resource_group"`
+	Location          string `bun:"location" json:"location"`
+	IPAddress         net.IP `bun:"ip_address" json:"ip_address"`
+	IPVersion         string `bun:"ip_version" json:"ip_version"`
+	SKUName           string `bun:"sku_name" json:"sku_name"`
+	SKUTier           string `bun:"sku_tier" json:"sku_tier"`
+	ProvisioningState string `bun:"provisioning_state" json:"provisioning_state"`
+	FQDN              string `bun:"fqdn" json:"fqdn"`
+}
+
+// OrphanPublicAddressOpenStack represents an OpenStack floating IP address,
+// which has been identified as being orphan.
+type OrphanPublicAddressOpenStack struct {
+	FloatingIPID      string `bun:"floating_ip_id" json:"floating_ip_id"`
+	FloatingIP        net.IP `bun:"floating_ip" json:"floating_ip"`
+	FixedIP           net.IP `bun:"fixed_ip" json:"fixed_ip"`
+	ProjectID         string `bun:"project_id" json:"project_id"`
+	Domain            string `bun:"domain" json:"domain"`
+	Region            string `bun:"region" json:"region"`
+	PortID            string `bun:"port_id" json:"port_id"`
+	RouterID          string `bun:"router_id" json:"router_id"`
+	FloatingNetworkID string `bun:"floating_network_id" json:"floating_network_id"`
+	Status            string `bun:"status" json:"status"`
+	Description       string `bun:"description" json:"description"`
+	IPCreatedAt       string `bun:"ip_created_at" json:"ip_created_at"`
+	IPUpdatedAt       string `bun:"ip_updated_at" json:"ip_updated_at"`
+}
+
+// LoadBalancerListener represents a listener or frontend of a load balancer,
+// which describes what the load balancer exposes.
+type LoadBalancerListener struct {
+	Name        string `json:"name,omitempty"`
+	Address     string `json:"address,omitempty"`
+	Protocol    string `json:"protocol"`
+	Port        int    `json:"port"`
+	BackendPort int    `json:"backend_port,omitempty"`
+}
+
+// OrphanLoadBalancerAWS represents an AWS Elastic Load Balancer (Classic,
+// Application or Network), which has been identified as being orphan.
+//
+// Listeners are expected to be returned by the query as a JSON array.
+type OrphanLoadBalancerAWS struct {
+	Name        string                 `bun:"name" json:"name"`
+	ARN         string                 `bun:"arn" json:"arn"`
+	DNSName     string                 `bun:"dns_name" json:"dns_name"`
+	Type        string                 `bun:"type" json:"type"`
+	Scheme      string                 `bun:"scheme" json:"scheme"`
+	State       string                 `bun:"state" json:"state"`
+	VpcID       string                 `bun:"vpc_id" json:"vpc_id"`
+	RegionName  string                 `bun:"region_name" json:"region_name"`
+	AccountID   string                 `bun:"account_id" json:"account_id"`
+	CreatedTime time.Time              `bun:"created_time" json:"created_time"`
+	Listeners   []LoadBalancerListener `bun:"listeners" json:"listeners"`
+}
+
+// OrphanLoadBalancerAzure represents an Azure load balancer, which has been
+// identified as being orphan.
+//
+// Frontends are expected to be returned by the query as a JSON array.
+type OrphanLoadBalancerAzure struct {
+	Name              string                 `bun:"name" json:"name"`
+	SubscriptionID    string                 `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup     string                 `bun:"resource_group" json:"resource_group"`
+	Location          string                 `bun:"location" json:"location"`
+	SKUName           string                 `bun:"sku_name" json:"sku_name"`
+	ProvisioningState string                 `bun:"provisioning_state" json:"provisioning_state"`
+	Frontends         []LoadBalancerListener `bun:"frontends" json:"frontends"`
+}
+
+// OrphanLoadBalancerOpenStack represents an OpenStack Octavia load balancer,
+// which has been identified as being orphan.
+//
+// Listeners are expected to be returned by the query as a JSON array.
+type OrphanLoadBalancerOpenStack struct {
+	LoadBalancerID     string                 `bun:"load_balancer_id" json:"load_balancer_id"`
+	Name               string                 `bun:"name" json:"name"`
+	ProjectID          string                 `bun:"project_id" json:"project_id"`
+	Domain             string                 `bun:"domain" json:"domain"`
+	Region             string                 `bun:"region" json:"region"`
+	Provider           string                 `bun:"provider" json:"provider"`
+	VipAddress         net.IP                 `bun:"vip_address" json:"vip_address"`
+	VipSubnetID        string                 `bun:"vip_subnet_id" json:"vip_subnet_id"`
+	OperatingStatus    string                 `bun:"operating_status" json:"operating_status"`
+	ProvisioningStatus string                 `bun:"provisioning_status" json:"provisioning_status"`
+	LBCreatedAt        string                 `bun:"lb_created_at" json:"lb_created_at"`
+	LBUpdatedAt        string                 `bun:"lb_updated_at" json:"lb_updated_at"`
+	Listeners          []LoadBalancerListener `bun:"listeners" json:"listeners"`
+}
+
+// OrphanBucketAWS represents an AWS S3 bucket, which has been identified as
+// being orphan.
+type OrphanBucketAWS struct {
+	Name         string    `bun:"name" json:"name"`
+	RegionName   string    `bun:"region_name" json:"region_name"`
+	AccountID    string    `bun:"account_id" json:"account_id"`
+	CreationTime time.Time `bun:"creation_time" json:"creation_time"`
+	PublicAccess bool      `bun:"public_access" json:"public_access"`
+}
+
+// OrphanBucketGCP represents a GCP Cloud Storage bucket, which has been
+// identified as being orphan.
+type OrphanBucketGCP struct {
+	Name         string    `bun:"name" json:"name"`
+	ProjectID    string    `bun:"project_id" json:"project_id"`
+	Location     string    `bun:"location" json:"location"`
+	LocationType string    `bun:"location_type" json:"location_type"`
+	StorageClass string    `bun:"storage_class" json:"storage_class"`
+	CreationTime time.Time `bun:"creation_time" json:"creation_time"`
+	PublicAccess bool      `bun:"public_access" json:"public_access"`
+}
+
+// OrphanBucketAzure represents an Azure blob container within a storage
+// account, which has been identified as being orphan.
+type OrphanBucketAzure struct {
+	Name              string    `bun:"name" json:"name"`
+	StorageAccount    string    `bun:"storage_account" json:"storage_account"`
+	SubscriptionID    string    `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup     string    `bun:"resource_group" json:"resource_group"`
+	Location          string    `bun:"location" json:"location"`
+	PublicAccessLevel string    `bun:"public_access_level" json:"public_access_level"`
+	CreationTime      time.Time `bun:"creation_time" json:"creation_time"`
+	PublicAccess      bool      `bun:"public_access" json:"public_access"`
+}
+
+// OrphanBucketOpenStack represents an OpenStack Swift container, which has
+// been identified as being orphan.
+type OrphanBucketOpenStack struct {
+	Name         string    `bun:"name" json:"name"`
+	ProjectID    string    `bun:"project_id" json:"project_id"`
+	Domain       string    `bun:"domain" json:"domain"`
+	Region       string    `bun:"region" json:"region"`
+	ObjectCount  int64     `bun:"object_count" json:"object_count"`
+	BytesUsed    int64     `bun:"bytes_used" json:"bytes_used"`
+	CreationTime time.Time `bun:"creation_time" json:"creation_time"`
+	PublicAccess bool      `bun:"public_access" json:"public_access"`
+}
+
+// OrphanVPCAWS represents an AWS VPC, which has been identified as being
+// orphan.
+type OrphanVPCAWS struct {
+	VpcID      string `bun:"vpc_id" json:"vpc_id"`
+	Name       string `bun:"name" json:"name"`
+	CidrBlock  string `bun:"cidr_block" json:"cidr_block"`
+	State      string `bun:"state" json:"state"`
+	IsDefault  bool   `bun:"is_default" json:"is_default"`
+	RegionName string `bun:"region_name" json:"region_name"`
+	AccountID  string `bun:"account_id" json:"account_id"`
+}
+
+// OrphanSubnetAWS represents an AWS subnet, which has been identified as being
+// orphan.
+type OrphanSubnetAWS struct {
+	SubnetID         string `bun:"subnet_id" json:"subnet_id"`
+	Name             string `bun:"name" json:"name"`
+	VpcID            string `bun:"vpc_id" json:"vpc_id"`
+	VpcName          string `bun:"vpc_name" json:"vpc_name"`
+	CidrBlock        string `bun:"cidr_block" json:"cidr_block"`
+	AvailabilityZone string `bun:"availability_zone" json:"availability_zone"`
+	State            string `bun:"state" json:"state"`
+	RegionName       string `bun:"region_name" json:"region_name"`
+	AccountID        string `bun:"account_id" json:"account_id"`
+}
+
+// OrphanSecurityGroupAWS represents an AWS security group, which has been
+// identified as being orphan.
+type OrphanSecurityGroupAWS struct {
+	GroupID     string `bun:"group_id" json:"group_id"`
+	Name        string `bun:"name" json:"name"`
+	Description string `bun:"description" json:"description"`
+	VpcID       string `bun:"vpc_id" json:"vpc_id"`
+	VpcName     string `bun:"vpc_name" json:"vpc_name"`
+	RegionName  string `bun:"region_name" json:"region_name"`
+	AccountID   string `bun:"account_id" json:"account_id"`
+}
+
+// OrphanNetworkGCP represents a GCP VPC network, which has been identified as
+// being orphan.
+type OrphanNetworkGCP struct {
+	Name                  string `bun:"name" json:"name"`
+	ProjectID             string `bun:"project_id" json:"project_id"`
+	Description           string `bun:"description" json:"description"`
+	AutoCreateSubnetworks bool   `bun:"auto_create_subnetworks" json:"auto_create_subnetworks"`
+	RoutingMode           string `bun:"routing_mode" json:"routing_mode"`
+	CreationTimestamp     string `bun:"creation_timestamp" json:"creation_timestamp"`
+}
+
+// OrphanSubnetGCP represents a GCP subnetwork, which has been identified as
+// being orphan.
+type OrphanSubnetGCP struct {
+	Name              string `bun:"name" json:"name"`
+	ProjectID         string `bun:"project_id" json:"project_id"`
+	Region            string `bun:"region" json:"region"`
+	Network           string `bun:"network" json:"network"`
+	IPCidrRange       string `bun:"ip_cidr_range" json:"ip_cidr_range"`
+	Gateway           string `bun:"gateway" json:"gateway"`
+	Purpose           string `bun:"purpose" json:"purpose"`
+	Description       string `bun:"description" json:"description"`
+	CreationTimestamp string `bun:"creation_timestamp" json:"creation_timestamp"`
+}
+
+// OrphanFirewallRuleGCP represents a GCP firewall rule, which has been
+// identified as being orphan.
+type OrphanFirewallRuleGCP struct {
+	Name              string `bun:"name" json:"name"`
+	ProjectID         string `bun:"project_id" json:"project_id"`
+	Network           string `bun:"network" json:"network"`
+	Direction         string `bun:"direction" json:"direction"`
+	Priority          int32  `bun:"priority" json:"priority"`
+	Disabled          bool   `bun:"disabled" json:"disabled"`
+	Description       string `bun:"description" json:"description"`
+	CreationTimestamp string `bun:"creation_timestamp" json:"creation_timestamp"`
+}
+
+// OrphanImageAWS represents an AWS AMI, which has been identified as being
+// orphan.
+type OrphanImageAWS struct {
+	ImageID        string    `bun:"image_id" json:"image_id"`
+	Name           string    `bun:"name" json:"name"`
+	OwnerID        string    `bun:"owner_id" json:"owner_id"`
+	ImageType      string    `bun:"image_type" json:"image_type"`
+	RootDeviceType string    `bun:"root_device_type" json:"root_device_type"`
+	Description    string    `bun:"description" json:"description"`
+	State          string    `bun:"state" json:"state"`
+	RegionName     string    `bun:"region_name" json:"region_name"`
+	AccountID      string    `bun:"account_id" json:"account_id"`
+	CreationDate   time.Time `bun:"creation_date" json:"creation_date"`
+}
+
+// OrphanImageGCP represents a GCP Compute Engine image, which has been
+// identified as being orphan.
+type OrphanImageGCP struct {
+	Name              string `bun:"name" json:"name"`
+	ProjectID         string `bun:"project_id" json:"project_id"`
+	Family            string `bun:"family" json:"family"`
+	Status            string `bun:"status" json:"status"`
+	DiskSizeGB        int64  `bun:"disk_size_gb" json:"disk_size_gb"`
+	ArchiveSizeBytes  int64  `bun:"archive_size_bytes" json:"archive_size_bytes"`
+	Description       string `bun:"description" json:"description"`
+	CreationTimestamp string `bun:"creation_timestamp" json:"creation_timestamp"`
+}
+
+// OrphanImageAzure represents an Azure image, which has been identified as
+// being orphan.
+type OrphanImageAzure struct {
+	Name              string    `bun:"name" json:"name"`
+	SubscriptionID    string    `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup     string    `bun:"resource_group" json:"resource_group"`
+	Location          string    `bun:"location" json:"location"`
+	OSType            string    `bun:"os_type" json:"os_type"`
+	HyperVGeneration  string    `bun:"hyper_v_gen" json:"hyper_v_gen"`
+	ProvisioningState string    `bun:"provisioning_state" json:"provisioning_state"`
+	TimeCreated       time.Time `bun:"time_created" json:"time_created"`
+}
+
+// OrphanSnapshotAWS represents an AWS EBS snapshot, which has been identified
+// as being orphan.
+type OrphanSnapshotAWS struct {
+	SnapshotID  string    `bun:"snapshot_id" json:"snapshot_id"`
+	VolumeID    string    `bun:"volume_id" json:"volume_id"`
+	VolumeSize  int32     `bun:"volume_size" json:"volume_size"`
+	State       string    `bun:"state" json:"state"`
+	Encrypted   bool      `bun:"encrypted" json:"encrypted"`
+	Description string    `bun:"description" json:"description"`
+	RegionName  string    `bun:"region_name" json:"region_name"`
+	AccountID   string    `bun:"account_id" json:"account_id"`
+	StartTime   time.Time `bun:"start_time" json:"start_time"`
+}
+
+// OrphanSnapshotGCP represents a GCP disk snapshot, which has been identified
+// as being orphan.
+type OrphanSnapshotGCP struct {
+	Name              string `bun:"name" json:"name"`
+	ProjectID         string `bun:"project_id" json:"project_id"`
+	SourceDisk        string `bun:"source_disk" json:"source_disk"`
+	DiskSizeGB        int64  `bun:"disk_size_gb" json:"disk_size_gb"`
+	StorageBytes      int64  `bun:"storage_bytes" json:"storage_bytes"`
+	Status            string `bun:"status" json:"status"`
+	Description       string `bun:"description" json:"description"`
+	CreationTimestamp string `bun:"creation_timestamp" json:"creation_timestamp"`
+}
+
+// OrphanSnapshotAzure represents an Azure disk snapshot, which has been
+// identified as being orphan.
+type OrphanSnapshotAzure struct {
+	Name             string    `bun:"name" json:"name"`
+	SubscriptionID   string    `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup    string    `bun:"resource_group" json:"resource_group"`
+	Location         string    `bun:"location" json:"location"`
+	DiskSizeGB       int32     `bun:"disk_size_gb" json:"disk_size_gb"`
+	SourceResourceID string    `bun:"source_resource_id" json:"source_resource_id"`
+	Incremental      bool      `bun:"incremental" json:"incremental"`
+	TimeCreated      time.Time `bun:"time_created" json:"time_created"`
+}
+
+// OrphanDNSRecordAWS represents an AWS Route53 DNS record, which has been
+// identified as being orphan.
+//
+// Dangling specifies whether the record targets an address, which is no longer
+// owned.
+type OrphanDNSRecordAWS struct {
+	ZoneID    string `bun:"zone_id" json:"zone_id"`
+	ZoneName  string `bun:"zone_name" json:"zone_name"`
+	Name      string `bun:"name" json:"name"`
+	Type      string `bun:"type" json:"type"`
+	TTL       int64  `bun:"ttl" json:"ttl"`
+	Target    string `bun:"target" json:"target"`
+	AccountID string `bun:"account_id" json:"account_id"`
+	Dangling  bool   `bun:"dangling" json:"dangling"`
+}
+
+// OrphanDNSRecordGCP represents a GCP Cloud DNS record, which has been
+// identified as being orphan.
+//
+// Dangling specifies whether the record targets an address, which is no longer
+// owned.
+type OrphanDNSRecordGCP struct {
+	ManagedZone string `bun:"managed_zone" json:"managed_zone"`
+	ZoneName    string `bun:"zone_name" json:"zone_name"`
+	Name        string `bun:"name" json:"name"`
+	Type        string `bun:"type" json:"type"`
+	TTL         int64  `bun:"ttl" json:"ttl"`
+	Target      string `bun:"target" json:"target"`
+	ProjectID   string `bun:"project_id" json:"project_id"`
+	Dangling    bool   `bun:"dangling" json:"dangling"`
+}
+
+// OrphanDNSRecordAzure represents an Azure DNS record, which has been
+// identified as being orphan.
+//
+// Dangling specifies whether the record targets an address, which is no longer
+// owned.
+type OrphanDNSRecordAzure struct {
+	ZoneName       string `bun:"zone_name" json:"zone_name"`
+	Name           string `bun:"name" json:"name"`
+	Type           string `bun:"type" json:"type"`
+	TTL            int64  `bun:"ttl" json:"ttl"`
+	Target         string `bun:"target" json:"target"`
+	SubscriptionID string `bun:"subscription_id" json:"subscription_id"`
+	ResourceGroup  string `bun:"resource_group" json:"resource_group"`
+	Dangling       bool   `bun:"dangling" json:"dangling"`
+}
+
+// OrphanMachineGardener represents a Gardener Machine, which has been
+// identified as having no backing virtual machine in the cloud provider.
+type OrphanMachineGardener struct {
+	Name              string    `bun:"name" json:"name"`
+	Namespace         string    `bun:"namespace" json:"namespace"`
+	SeedName          string    `bun:"seed_name" json:"seed_name"`
+	ProviderID        string    `bun:"provider_id" json:"provider_id"`
+	Status            string    `bun:"status" json:"status"`
+	Node              string    `bun:"node" json:"node"`
+	CreationTimestamp time.Time `bun:"creation_timestamp" json:"creation_timestamp"`
+}
+
+// OrphanShootVirtualMachineGardener represents a virtual machine in a cloud
+// provider, which belongs to a Gardener shoot, which no longer exists.
+type OrphanShootVirtualMachineGardener struct {
+	Name         string `bun:"name" json:"name"`
+	InstanceID   string `bun:"instance_id" json:"instance_id"`
+	ProviderType string `bun:"provider_type" json:"provider_type"`
+	Account      string `bun:"account" json:"account"`
+	Region       string `bun:"region" json:"region"`
+	TechnicalID  string `bun:"technical_id" json:"technical_id"`
+	ProjectName  string `bun:"project_name" json:"project_name"`
+	ShootName    string `bun:"shoot_name" json:"shoot_name"`
+}
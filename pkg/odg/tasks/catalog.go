@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// ErrUnknownQuery is an error, which is returned by task handlers, when the
+// payload refers to a named query, which does not exist in the
+// [QueryCatalog].
+var ErrUnknownQuery = errors.New("unknown query")
+
+// ErrAmbiguousQuery is an error, which is returned by task handlers, when the
+// payload specifies both a query and the name of a query from the
+// [QueryCatalog].
+var ErrAmbiguousQuery = errors.New("both query and query name specified")
+
+// ErrAdHocQueryNotAllowed is an error, which is returned by task handlers, when
+// the payload specifies a query, but ad-hoc queries are not allowed.
+var ErrAdHocQueryNotAllowed = errors.New("ad-hoc queries not allowed")
+
+// DefaultQueryName is the name of the query from the [QueryCatalog], which is
+// used when the payload specifies neither a query, nor a query name.
+const DefaultQueryName = "default"
+
+// taskNamePrefix is the common prefix of the names of the tasks provided by
+// the extension.
+const taskNamePrefix = "odg:task:"
+
+// embeddedQueries contains the queries shipped with the extension.
+//
+// Queries are stored as queries/<task>/<name>.sql, where <task> is the name of
+// the task without the [taskNamePrefix].
+//
+//go:embed queries
+var embeddedQueries embed.FS
+
+// QueryCatalog is a collection of named queries, which are keyed by task name.
+type QueryCatalog map[string]map[string]string
+
+// Add adds the query with the given name for the given task to the catalog,
+// replacing any existing query with the same name.
+func (c QueryCatalog) Add(taskName, queryName, query string) {
+	queries, ok := c[taskName]
+	if !ok {
+		queries = make(map[string]string)
+		c[taskName] = queries
+	}
+	queries[queryName] = query
+}
+
+// Get returns the query with the given name for the given task.
+func (c QueryCatalog) Get(taskName, queryName string) (string, bool) {
+	query, ok := c[taskName][queryName]
+
+	return query, ok
+}
+
+// mustLoadEmbeddedQueries returns a new [QueryCatalog] with the queries shipped
+// with the extension.
+func mustLoadEmbeddedQueries() QueryCatalog {
+	catalog := make(QueryCatalog)
+	paths, err := fs.Glob(embeddedQueries, "queries/*/*.sql")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, p := range paths {
+		data, err := embeddedQueries.ReadFile(p)
+		if err != nil {
+			panic(err)
+		}
+
+		taskName := taskNamePrefix + path.Base(path.Dir(p))
+		queryName := strings.TrimSuffix(path.Base(p), ".sql")
+		catalog.Add(taskName, queryName, string(data))
+	}
+
+	return catalog
+}
+
+// DefaultQueryCatalog is the [QueryCatalog] used by the task handlers, which
+// initially contains the queries shipped with the extension.
+var DefaultQueryCatalog = mustLoadEmbeddedQueries()
+
+// AllowAdHocQueries specifies whether task handlers accept queries provided as
+// part of the payload, or only queries from the [QueryCatalog].
+var AllowAdHocQueries = true
+
+// SetAllowAdHocQueries configures whether task handlers accept queries provided
+// as part of the payload.
+func SetAllowAdHocQueries(v bool) {
+	AllowAdHocQueries = v
+}
+
+// resolveQuery resolves the query to use for the given task and payload.
+//
+// Queries specified as part of the payload take precedence, if ad-hoc queries
+// are allowed. Otherwise the query named in the payload, or the
+// [DefaultQueryName] query is looked up in the [DefaultQueryCatalog].
+func resolveQuery(taskName string, payload *Payload) error {
+	if payload.Query != "" {
+		if payload.QueryName != "" {
+			return ErrAmbiguousQuery
+		}
+
+		if !AllowAdHocQueries {
+			return ErrAdHocQueryNotAllowed
+		}
+
+		return nil
+	}
+
+	queryName := payload.QueryName
+	if queryName == "" {
+		queryName = DefaultQueryName
+	}
+
+	query, ok := DefaultQueryCatalog.Get(taskName, queryName)
+	if !ok {
+		if payload.QueryName == "" {
+			return ErrNoQuery
+		}
+
+		return fmt.Errorf("%w: %s", ErrUnknownQuery, queryName)
+	}
+
+	payload.Query = query
+	payload.QueryName = queryName
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMassDeletion is an error, which is returned by task handlers, when
+// reporting the orphan resources would delete more of the existing findings
+// than allowed by the configured [Guardrail].
+var ErrMassDeletion = errors.New("mass deletion guardrail triggered")
+
+// Guardrail protects existing findings in the Delivery Service from being
+// deleted en masse, e.g. when the query for orphan resources suddenly returns
+// no results, because an Inventory collection has been failing.
+//
+// The guardrail is triggered when a single run would delete more than
+// MaxDeletions items, which also account for more than MaxDeletionPercentage
+// of the existing items. Both thresholds must be exceeded, so that kinds of
+// orphan resources with only a few findings are not blocked by the regular
+// clean up of a few resources.
+//
+// Regardless of the thresholds, the guardrail is always triggered when a run
+// would delete existing items without discovering any orphan resources, since
+// an empty result most likely means that an Inventory collection has been
+// failing, rather than that all orphan resources have been cleaned up.
+type Guardrail struct {
+	// MaxDeletions specifies the number of items, which may always be
+	// deleted in a single run.
+	MaxDeletions int
+
+	// MaxDeletionPercentage specifies the maximum percentage of existing
+	// items, which may be deleted in a single run, once MaxDeletions is
+	// exceeded.
+	MaxDeletionPercentage float64
+}
+
+// DefaultGuardrail is the [Guardrail] used by the task handlers.
+var DefaultGuardrail = Guardrail{
+	MaxDeletions:          10,
+	MaxDeletionPercentage: 50.0,
+}
+
+// SetDefaultGuardrail sets the default [Guardrail] used by the task handlers.
+func SetDefaultGuardrail(g Guardrail) {
+	DefaultGuardrail = g
+}
+
+// Check returns an error wrapping [ErrMassDeletion], if deleting the given
+// number of items out of the existing items would violate the [Guardrail],
+// given the number of discovered orphan resources.
+func (g Guardrail) Check(existing, discovered, deletions int) error {
+	if existing == 0 || deletions == 0 {
+		return nil
+	}
+
+	if discovered == 0 {
+		return fmt.Errorf(
+			"%w: all %d items would be deleted, since no orphan resources have been discovered",
+			ErrMassDeletion,
+			existing,
+		)
+	}
+
+	if deletions <= g.MaxDeletions {
+		return nil
+	}
+
+	percentage := float64(deletions) / float64(existing) * 100.0
+	if percentage <= g.MaxDeletionPercentage {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: %d out of %d items (%.2f%%) would be deleted",
+		ErrMassDeletion,
+		deletions,
+		existing,
+		percentage,
+	)
+}
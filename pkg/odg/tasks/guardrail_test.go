@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGuardrailCheck(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		guardrail  Guardrail
+		existing   int
+		discovered int
+		deletions  int
+		wantErr    error
+	}{
+		{
+			desc:       "no existing items",
+			guardrail:  DefaultGuardrail,
+			existing:   0,
+			discovered: 0,
+			deletions:  0,
+		},
+		{
+			desc:       "no deletions",
+			guardrail:  DefaultGuardrail,
+			existing:   100,
+			discovered: 100,
+			deletions:  0,
+		},
+		{
+			desc:       "nothing discovered with few existing items",
+			guardrail:  DefaultGuardrail,
+			existing:   5,
+			discovered: 0,
+			deletions:  5,
+			wantErr:    ErrMassDeletion,
+		},
+		{
+			desc:       "deletions within max deletions",
+			guardrail:  DefaultGuardrail,
+			existing:   12,
+			discovered: 2,
+			deletions:  10,
+		},
+		{
+			desc:       "deletions within max deletion percentage",
+			guardrail:  DefaultGuardrail,
+			existing:   100,
+			discovered: 60,
+			deletions:  40,
+		},
+		{
+			desc:       "deletions exceeding both thresholds",
+			guardrail:  DefaultGuardrail,
+			existing:   100,
+			discovered: 40,
+			deletions:  60,
+			wantErr:    ErrMassDeletion,
+		},
+		{
+			desc:       "zero max deletions",
+			guardrail:  Guardrail{MaxDeletions: 0, MaxDeletionPercentage: 10},
+			existing:   10,
+			discovered: 8,
+			deletions:  2,
+			wantErr:    ErrMassDeletion,
+		},
+		{
+			desc:       "zero thresholds",
+			guardrail:  Guardrail{},
+			existing:   100,
+			discovered: 99,
+			deletions:  1,
+			wantErr:    ErrMassDeletion,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.guardrail.Check(tc.existing, tc.discovered, tc.deletions)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -29,6 +29,28 @@ var (
 		[]string{"provider_name", "resource_kind"},
 		nil,
 	)
+
+	// guardrailTriggeredDesc is the descriptor for a metric, which tracks
+	// whether reporting orphan resources has been aborted by the mass
+	// deletion guardrail.
+	guardrailTriggeredDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "odg_guardrail_triggered"),
+		"A gauge which tracks whether reporting has been aborted by the mass deletion guardrail",
+		[]string{"provider_name", "resource_kind"},
+		nil,
+	)
+
+	// partialFailureDesc is the descriptor for a metric, which tracks
+	// the outcome of partial failures when applying changes to the Open
+	// Delivery Gear API. The value of the metric is the number of deleted
+	// entries of the given kind, which were either restored or failed to
+	// be restored by a compensating rollback.
+	partialFailureDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "", "odg_partial_failure"),
+		"A gauge which tracks the number of entries subject to rollback after a partial failure",
+		[]string{"provider_name", "resource_kind", "stage", "entries", "outcome"},
+		nil,
+	)
 )
 
 // init registers the metric descriptors with [metrics.DefaultCollector]
@@ -36,5 +58,7 @@ func init() {
 	metrics.DefaultCollector.AddDesc(
 		discoveredOrphanResourcesDesc,
 		reportedOrphanResourcesDesc,
+		guardrailTriggeredDesc,
+		partialFailureDesc,
 	)
 }
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanBucketsAWS is the name of the task, which reports orphan AWS
+// S3 buckets as findings.
+const TaskReportOrphanBucketsAWS = "odg:task:report-orphan-buckets-aws"
+
+// orphanBucketsAWSReporter reports orphan AWS S3 buckets as findings.
+var orphanBucketsAWSReporter = NewReporter(Kind[models.OrphanBucketAWS]{
+	TaskName:     TaskReportOrphanBucketsAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindBucketAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanBucketAWS) apitypes.SeverityLevel {
+		return publicAccessSeverity(item.PublicAccess)
+	},
+	Summary: "Orphan Bucket",
+	ArtefactName: func(item models.OrphanBucketAWS) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanBucketAWS) string {
+		return item.Name
+	},
+	ArtefactExtraID: func(item models.OrphanBucketAWS) map[string]string {
+		return map[string]string{
+			"bucket_name": item.Name,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanBucketsAWS is a handler, which reports orphan AWS S3
+// buckets as findings.
+func HandleReportOrphanBucketsAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanBucketsAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanBucketsAWS,
+		asynq.HandlerFunc(HandleReportOrphanBucketsAWS),
+	)
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanBucketsAzure is the name of the task, which reports orphan
+// Azure blob containers as findings.
+const TaskReportOrphanBucketsAzure = "odg:task:report-orphan-buckets-az"
+
+// orphanBucketsAzureReporter reports orphan Azure blob containers as findings.
+var orphanBucketsAzureReporter = NewReporter(Kind[models.OrphanBucketAzure]{
+	TaskName:     TaskReportOrphanBucketsAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindBucketAzure,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanBucketAzure) apitypes.SeverityLevel {
+		return publicAccessSeverity(item.PublicAccess)
+	},
+	Summary: "Orphan Bucket",
+	ArtefactName: func(item models.OrphanBucketAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanBucketAzure) string {
+		return fmt.Sprintf("%s/%s", item.StorageAccount, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanBucketAzure) map[string]string {
+		return map[string]string{
+			"container_name":  item.Name,
+			"storage_account": item.StorageAccount,
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+		}
+	},
+})
+
+// HandleReportOrphanBucketsAzure is a handler, which reports orphan Azure blob
+// containers as findings.
+func HandleReportOrphanBucketsAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanBucketsAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanBucketsAzure,
+		asynq.HandlerFunc(HandleReportOrphanBucketsAzure),
+	)
+}
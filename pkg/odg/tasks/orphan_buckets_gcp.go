@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanBucketsGCP is the name of the task, which reports orphan GCP
+// Cloud Storage buckets as findings.
+const TaskReportOrphanBucketsGCP = "odg:task:report-orphan-buckets-gcp"
+
+// orphanBucketsGCPReporter reports orphan GCP Cloud Storage buckets as
+// findings.
+var orphanBucketsGCPReporter = NewReporter(Kind[models.OrphanBucketGCP]{
+	TaskName:     TaskReportOrphanBucketsGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindBucketGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanBucketGCP) apitypes.SeverityLevel {
+		return publicAccessSeverity(item.PublicAccess)
+	},
+	Summary: "Orphan Bucket",
+	ArtefactName: func(item models.OrphanBucketGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanBucketGCP) string {
+		return item.Name
+	},
+	ArtefactExtraID: func(item models.OrphanBucketGCP) map[string]string {
+		return map[string]string{
+			"bucket_name": item.Name,
+			"project_id":  item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanBucketsGCP is a handler, which reports orphan GCP Cloud
+// Storage buckets as findings.
+func HandleReportOrphanBucketsGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanBucketsGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanBucketsGCP,
+		asynq.HandlerFunc(HandleReportOrphanBucketsGCP),
+	)
+}
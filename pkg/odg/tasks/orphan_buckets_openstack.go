@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanBucketsOpenStack is the name of the task, which reports
+// orphan OpenStack Swift containers as findings.
+const TaskReportOrphanBucketsOpenStack = "odg:task:report-orphan-buckets-openstack"
+
+// orphanBucketsOpenStackReporter reports orphan OpenStack Swift containers as
+// findings.
+var orphanBucketsOpenStackReporter = NewReporter(Kind[models.OrphanBucketOpenStack]{
+	TaskName:     TaskReportOrphanBucketsOpenStack,
+	ProviderName: apitypes.ProviderNameOpenStack,
+	ResourceKind: apitypes.ResourceKindBucketOpenStack,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanBucketOpenStack) apitypes.SeverityLevel {
+		return publicAccessSeverity(item.PublicAccess)
+	},
+	Summary: "Orphan Bucket",
+	ArtefactName: func(item models.OrphanBucketOpenStack) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanBucketOpenStack) string {
+		return fmt.Sprintf("%s:%s", item.ProjectID, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanBucketOpenStack) map[string]string {
+		return map[string]string{
+			"container_name": item.Name,
+			"project_id":     item.ProjectID,
+			"region":         item.Region,
+		}
+	},
+})
+
+// HandleReportOrphanBucketsOpenStack is a handler, which reports orphan
+// OpenStack Swift containers as findings.
+func HandleReportOrphanBucketsOpenStack(ctx context.Context, t *asynq.Task) error {
+	return orphanBucketsOpenStackReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanBucketsOpenStack,
+		asynq.HandlerFunc(HandleReportOrphanBucketsOpenStack),
+	)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanDisksAzure is the name of the task, which reports orphan
+// Azure managed disks as findings.
+const TaskReportOrphanDisksAzure = "odg:task:report-orphan-disks-az"
+
+// orphanDisksAzureReporter reports orphan Azure managed disks as findings.
+var orphanDisksAzureReporter = NewReporter(Kind[models.OrphanDiskAzure]{
+	TaskName:     TaskReportOrphanDisksAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindDiskAzure,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Disk",
+	ArtefactName: func(item models.OrphanDiskAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanDiskAzure) string {
+		return fmt.Sprintf("%s:%s:%s", item.SubscriptionID, item.ResourceGroup, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanDiskAzure) map[string]string {
+		return map[string]string{
+			"disk_name":       item.Name,
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+		}
+	},
+})
+
+// HandleReportOrphanDisksAzure is a handler, which reports orphan Azure managed
+// disks as findings.
+func HandleReportOrphanDisksAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanDisksAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanDisksAzure,
+		asynq.HandlerFunc(HandleReportOrphanDisksAzure),
+	)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanDisksGCP is the name of the task, which reports orphan GCP
+// persistent disks as findings.
+const TaskReportOrphanDisksGCP = "odg:task:report-orphan-disks-gcp"
+
+// orphanDisksGCPReporter reports orphan GCP persistent disks as findings.
+var orphanDisksGCPReporter = NewReporter(Kind[models.OrphanDiskGCP]{
+	TaskName:     TaskReportOrphanDisksGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindDiskGCP,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Disk",
+	ArtefactName: func(item models.OrphanDiskGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanDiskGCP) string {
+		return fmt.Sprintf("%s:%s:%s", item.ProjectID, item.Zone, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanDiskGCP) map[string]string {
+		return map[string]string{
+			"disk_name":  item.Name,
+			"project_id": item.ProjectID,
+			"zone":       item.Zone,
+		}
+	},
+})
+
+// HandleReportOrphanDisksGCP is a handler, which reports orphan GCP persistent
+// disks as findings.
+func HandleReportOrphanDisksGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanDisksGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanDisksGCP,
+		asynq.HandlerFunc(HandleReportOrphanDisksGCP),
+	)
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanDNSRecordsAWS is the name of the task, which reports orphan
+// AWS Route53 DNS records as findings.
+const TaskReportOrphanDNSRecordsAWS = "odg:task:report-orphan-dns-records-aws"
+
+// orphanDNSRecordsAWSReporter reports orphan AWS Route53 DNS records as
+// findings.
+var orphanDNSRecordsAWSReporter = NewReporter(Kind[models.OrphanDNSRecordAWS]{
+	TaskName:     TaskReportOrphanDNSRecordsAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindDNSRecordAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanDNSRecordAWS) apitypes.SeverityLevel {
+		return danglingRecordSeverity(item.Dangling)
+	},
+	Summary: "Orphan DNS Record",
+	ArtefactName: func(item models.OrphanDNSRecordAWS) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanDNSRecordAWS) string {
+		return fmt.Sprintf("%s:%s:%s", item.ZoneID, item.Name, item.Type)
+	},
+	ArtefactExtraID: func(item models.OrphanDNSRecordAWS) map[string]string {
+		return map[string]string{
+			"zone_id":     item.ZoneID,
+			"record_name": item.Name,
+			"record_type": item.Type,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanDNSRecordsAWS is a handler, which reports orphan AWS
+// Route53 DNS records as findings.
+func HandleReportOrphanDNSRecordsAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanDNSRecordsAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanDNSRecordsAWS,
+		asynq.HandlerFunc(HandleReportOrphanDNSRecordsAWS),
+	)
+}
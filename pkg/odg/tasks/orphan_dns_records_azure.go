@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanDNSRecordsAzure is the name of the task, which reports orphan
+// Azure DNS records as findings.
+const TaskReportOrphanDNSRecordsAzure = "odg:task:report-orphan-dns-records-az"
+
+// orphanDNSRecordsAzureReporter reports orphan Azure DNS records as findings.
+var orphanDNSRecordsAzureReporter = NewReporter(Kind[models.OrphanDNSRecordAzure]{
+	TaskName:     TaskReportOrphanDNSRecordsAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindDNSRecordAzure,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanDNSRecordAzure) apitypes.SeverityLevel {
+		return danglingRecordSeverity(item.Dangling)
+	},
+	Summary: "Orphan DNS Record",
+	ArtefactName: func(item models.OrphanDNSRecordAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanDNSRecordAzure) string {
+		return fmt.Sprintf("%s:%s:%s:%s:%s", item.SubscriptionID, item.ResourceGroup, item.ZoneName, item.Name, item.Type)
+	},
+	ArtefactExtraID: func(item models.OrphanDNSRecordAzure) map[string]string {
+		return map[string]string{
+			"zone_name":       item.ZoneName,
+			"record_name":     item.Name,
+			"record_type":     item.Type,
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+		}
+	},
+})
+
+// HandleReportOrphanDNSRecordsAzure is a handler, which reports orphan Azure
+// DNS records as findings.
+func HandleReportOrphanDNSRecordsAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanDNSRecordsAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanDNSRecordsAzure,
+		asynq.HandlerFunc(HandleReportOrphanDNSRecordsAzure),
+	)
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanDNSRecordsGCP is the name of the task, which reports orphan
+// GCP Cloud DNS records as findings.
+const TaskReportOrphanDNSRecordsGCP = "odg:task:report-orphan-dns-records-gcp"
+
+// orphanDNSRecordsGCPReporter reports orphan GCP Cloud DNS records as findings.
+var orphanDNSRecordsGCPReporter = NewReporter(Kind[models.OrphanDNSRecordGCP]{
+	TaskName:     TaskReportOrphanDNSRecordsGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindDNSRecordGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	SeverityFunc: func(item models.OrphanDNSRecordGCP) apitypes.SeverityLevel {
+		return danglingRecordSeverity(item.Dangling)
+	},
+	Summary: "Orphan DNS Record",
+	ArtefactName: func(item models.OrphanDNSRecordGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanDNSRecordGCP) string {
+		return fmt.Sprintf("%s:%s:%s:%s", item.ProjectID, item.ManagedZone, item.Name, item.Type)
+	},
+	ArtefactExtraID: func(item models.OrphanDNSRecordGCP) map[string]string {
+		return map[string]string{
+			"managed_zone": item.ManagedZone,
+			"record_name":  item.Name,
+			"record_type":  item.Type,
+			"project_id":   item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanDNSRecordsGCP is a handler, which reports orphan GCP Cloud
+// DNS records as findings.
+func HandleReportOrphanDNSRecordsGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanDNSRecordsGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanDNSRecordsGCP,
+		asynq.HandlerFunc(HandleReportOrphanDNSRecordsGCP),
+	)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanFirewallRulesGCP is the name of the task, which reports
+// orphan GCP firewall rules as findings.
+const TaskReportOrphanFirewallRulesGCP = "odg:task:report-orphan-firewall-rules-gcp"
+
+// orphanFirewallRulesGCPReporter reports orphan GCP firewall rules as findings.
+var orphanFirewallRulesGCPReporter = NewReporter(Kind[models.OrphanFirewallRuleGCP]{
+	TaskName:     TaskReportOrphanFirewallRulesGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindFirewallRuleGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Firewall Rule",
+	ArtefactName: func(item models.OrphanFirewallRuleGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanFirewallRuleGCP) string {
+		return fmt.Sprintf("%s:%s", item.ProjectID, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanFirewallRuleGCP) map[string]string {
+		return map[string]string{
+			"firewall_name": item.Name,
+			"project_id":    item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanFirewallRulesGCP is a handler, which reports orphan GCP
+// firewall rules as findings.
+func HandleReportOrphanFirewallRulesGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanFirewallRulesGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanFirewallRulesGCP,
+		asynq.HandlerFunc(HandleReportOrphanFirewallRulesGCP),
+	)
+}
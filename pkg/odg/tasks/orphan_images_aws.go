@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanImagesAWS is the name of the task, which reports orphan AWS
+// AMIs as findings.
+const TaskReportOrphanImagesAWS = "odg:task:report-orphan-images-aws"
+
+// orphanImagesAWSReporter reports orphan AWS AMIs as findings.
+var orphanImagesAWSReporter = NewReporter(Kind[models.OrphanImageAWS]{
+	TaskName:     TaskReportOrphanImagesAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindImageAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Image",
+	ArtefactName: func(item models.OrphanImageAWS) string {
+		return item.ImageID
+	},
+	ResourceName: func(item models.OrphanImageAWS) string {
+		return item.ImageID
+	},
+	ArtefactExtraID: func(item models.OrphanImageAWS) map[string]string {
+		return map[string]string{
+			"image_id":    item.ImageID,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanImagesAWS is a handler, which reports orphan AWS AMIs as
+// findings.
+func HandleReportOrphanImagesAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanImagesAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanImagesAWS,
+		asynq.HandlerFunc(HandleReportOrphanImagesAWS),
+	)
+}
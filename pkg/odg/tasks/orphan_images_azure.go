@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanImagesAzure is the name of the task, which reports orphan
+// Azure images as findings.
+const TaskReportOrphanImagesAzure = "odg:task:report-orphan-images-az"
+
+// orphanImagesAzureReporter reports orphan Azure images as findings.
+var orphanImagesAzureReporter = NewReporter(Kind[models.OrphanImageAzure]{
+	TaskName:     TaskReportOrphanImagesAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindImageAzure,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Image",
+	ArtefactName: func(item models.OrphanImageAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanImageAzure) string {
+		return fmt.Sprintf("%s:%s:%s", item.SubscriptionID, item.ResourceGroup, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanImageAzure) map[string]string {
+		return map[string]string{
+			"image_name":      item.Name,
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+		}
+	},
+})
+
+// HandleReportOrphanImagesAzure is a handler, which reports orphan Azure images
+// as findings.
+func HandleReportOrphanImagesAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanImagesAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanImagesAzure,
+		asynq.HandlerFunc(HandleReportOrphanImagesAzure),
+	)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanImagesGCP is the name of the task, which reports orphan GCP
+// Compute Engine images as findings.
+const TaskReportOrphanImagesGCP = "odg:task:report-orphan-images-gcp"
+
+// orphanImagesGCPReporter reports orphan GCP Compute Engine images as findings.
+var orphanImagesGCPReporter = NewReporter(Kind[models.OrphanImageGCP]{
+	TaskName:     TaskReportOrphanImagesGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindImageGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Image",
+	ArtefactName: func(item models.OrphanImageGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanImageGCP) string {
+		return fmt.Sprintf("%s:%s", item.ProjectID, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanImageGCP) map[string]string {
+		return map[string]string{
+			"image_name": item.Name,
+			"project_id": item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanImagesGCP is a handler, which reports orphan GCP Compute
+// Engine images as findings.
+func HandleReportOrphanImagesGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanImagesGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanImagesGCP,
+		asynq.HandlerFunc(HandleReportOrphanImagesGCP),
+	)
+}
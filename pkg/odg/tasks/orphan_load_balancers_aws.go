@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanLoadBalancersAWS is the name of the task, which reports
+// orphan AWS Elastic Load Balancers as findings.
+const TaskReportOrphanLoadBalancersAWS = "odg:task:report-orphan-load-balancers-aws"
+
+// orphanLoadBalancersAWSReporter reports orphan AWS Elastic Load Balancers as
+// findings.
+var orphanLoadBalancersAWSReporter = NewReporter(Kind[models.OrphanLoadBalancerAWS]{
+	TaskName:     TaskReportOrphanLoadBalancersAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindLoadBalancerAWS,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Load Balancer",
+	ArtefactName: func(item models.OrphanLoadBalancerAWS) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanLoadBalancerAWS) string {
+		return item.DNSName
+	},
+	// Classic and v2 load balancers have separate namespaces, so the same
+	// name may be used by both in the same account and region.
+	ArtefactExtraID: func(item models.OrphanLoadBalancerAWS) map[string]string {
+		return map[string]string{
+			"load_balancer_name": item.Name,
+			"load_balancer_type": item.Type,
+			"region_name":        item.RegionName,
+			"account_id":         item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanLoadBalancersAWS is a handler, which reports orphan AWS
+// Elastic Load Balancers as findings.
+func HandleReportOrphanLoadBalancersAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanLoadBalancersAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanLoadBalancersAWS,
+		asynq.HandlerFunc(HandleReportOrphanLoadBalancersAWS),
+	)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanLoadBalancersAzure is the name of the task, which reports
+// orphan Azure load balancers as findings.
+const TaskReportOrphanLoadBalancersAzure = "odg:task:report-orphan-load-balancers-az"
+
+// orphanLoadBalancersAzureReporter reports orphan Azure load balancers as
+// findings.
+var orphanLoadBalancersAzureReporter = NewReporter(Kind[models.OrphanLoadBalancerAzure]{
+	TaskName:     TaskReportOrphanLoadBalancersAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindLoadBalancerAzure,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Load Balancer",
+	ArtefactName: func(item models.OrphanLoadBalancerAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanLoadBalancerAzure) string {
+		return fmt.Sprintf("%s:%s:%s", item.SubscriptionID, item.ResourceGroup, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanLoadBalancerAzure) map[string]string {
+		return map[string]string{
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+			"location":        item.Location,
+		}
+	},
+})
+
+// HandleReportOrphanLoadBalancersAzure is a handler, which reports orphan Azure
+// load balancers as findings.
+func HandleReportOrphanLoadBalancersAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanLoadBalancersAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanLoadBalancersAzure,
+		asynq.HandlerFunc(HandleReportOrphanLoadBalancersAzure),
+	)
+}
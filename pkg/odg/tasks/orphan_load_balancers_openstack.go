@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanLoadBalancersOpenStack is the name of the task, which reports
+// orphan OpenStack Octavia load balancers as findings.
+const TaskReportOrphanLoadBalancersOpenStack = "odg:task:report-orphan-load-balancers-openstack"
+
+// orphanLoadBalancersOpenStackReporter reports orphan OpenStack Octavia load
+// balancers as findings.
+var orphanLoadBalancersOpenStackReporter = NewReporter(Kind[models.OrphanLoadBalancerOpenStack]{
+	TaskName:     TaskReportOrphanLoadBalancersOpenStack,
+	ProviderName: apitypes.ProviderNameOpenStack,
+	ResourceKind: apitypes.ResourceKindLoadBalancerOpenStack,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Load Balancer",
+	ArtefactName: func(item models.OrphanLoadBalancerOpenStack) string {
+		return item.LoadBalancerID
+	},
+	ResourceName: func(item models.OrphanLoadBalancerOpenStack) string {
+		return item.LoadBalancerID
+	},
+	ArtefactExtraID: func(item models.OrphanLoadBalancerOpenStack) map[string]string {
+		return map[string]string{
+			"load_balancer_id": item.LoadBalancerID,
+			"project_id":       item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanLoadBalancersOpenStack is a handler, which reports orphan
+// OpenStack Octavia load balancers as findings.
+func HandleReportOrphanLoadBalancersOpenStack(ctx context.Context, t *asynq.Task) error {
+	return orphanLoadBalancersOpenStackReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanLoadBalancersOpenStack,
+		asynq.HandlerFunc(HandleReportOrphanLoadBalancersOpenStack),
+	)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanMachinesGardener is the name of the task, which reports
+// orphan Gardener Machines, which have no backing virtual machine, as findings.
+const TaskReportOrphanMachinesGardener = "odg:task:report-orphan-machines-gardener"
+
+// orphanMachinesGardenerReporter reports orphan Gardener Machines, which have
+// no backing virtual machine, as findings.
+var orphanMachinesGardenerReporter = NewReporter(Kind[models.OrphanMachineGardener]{
+	TaskName:     TaskReportOrphanMachinesGardener,
+	ProviderName: apitypes.ProviderNameGardener,
+	ResourceKind: apitypes.ResourceKindMachineGardener,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Machine",
+	ArtefactName: func(item models.OrphanMachineGardener) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanMachineGardener) string {
+		return fmt.Sprintf("%s:%s:%s", item.SeedName, item.Namespace, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanMachineGardener) map[string]string {
+		return map[string]string{
+			"seed_name":    item.SeedName,
+			"namespace":    item.Namespace,
+			"machine_name": item.Name,
+		}
+	},
+})
+
+// HandleReportOrphanMachinesGardener is a handler, which reports orphan
+// Gardener Machines, which have no backing virtual machine, as findings.
+func HandleReportOrphanMachinesGardener(ctx context.Context, t *asynq.Task) error {
+	return orphanMachinesGardenerReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanMachinesGardener,
+		asynq.HandlerFunc(HandleReportOrphanMachinesGardener),
+	)
+}
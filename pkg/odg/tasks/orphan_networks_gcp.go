@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanNetworksGCP is the name of the task, which reports orphan GCP
+// VPC networks as findings.
+const TaskReportOrphanNetworksGCP = "odg:task:report-orphan-networks-gcp"
+
+// orphanNetworksGCPReporter reports orphan GCP VPC networks as findings.
+var orphanNetworksGCPReporter = NewReporter(Kind[models.OrphanNetworkGCP]{
+	TaskName:     TaskReportOrphanNetworksGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindNetworkGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Network",
+	ArtefactName: func(item models.OrphanNetworkGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanNetworkGCP) string {
+		return fmt.Sprintf("%s:%s", item.ProjectID, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanNetworkGCP) map[string]string {
+		return map[string]string{
+			"network_name": item.Name,
+			"project_id":   item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanNetworksGCP is a handler, which reports orphan GCP VPC
+// networks as findings.
+func HandleReportOrphanNetworksGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanNetworksGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanNetworksGCP,
+		asynq.HandlerFunc(HandleReportOrphanNetworksGCP),
+	)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanPublicAddressAWS is the name of the task, which reports
+// orphan AWS Elastic IP addresses as findings.
+const TaskReportOrphanPublicAddressAWS = "odg:task:report-orphan-ip-addresses-aws"
+
+// orphanPublicAddressAWSReporter reports orphan AWS Elastic IP addresses as
+// findings.
+var orphanPublicAddressAWSReporter = NewReporter(Kind[models.OrphanPublicAddressAWS]{
+	TaskName:     TaskReportOrphanPublicAddressAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindIPAddressAWS,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Public IP Address",
+	ArtefactName: func(item models.OrphanPublicAddressAWS) string {
+		return item.AllocationID
+	},
+	ResourceName: func(item models.OrphanPublicAddressAWS) string {
+		return item.AllocationID
+	},
+	ArtefactExtraID: func(item models.OrphanPublicAddressAWS) map[string]string {
+		return map[string]string{
+			"allocation_id": item.AllocationID,
+			"region_name":   item.RegionName,
+			"account_id":    item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanPublicAddressAWS is a handler, which reports orphan AWS
+// Elastic IP addresses as findings.
+func HandleReportOrphanPublicAddressAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanPublicAddressAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanPublicAddressAWS,
+		asynq.HandlerFunc(HandleReportOrphanPublicAddressAWS),
+	)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanPublicAddressAzure is the name of the task, which reports
+// orphan Azure public IP addresses as findings.
+const TaskReportOrphanPublicAddressAzure = "odg:task:report-orphan-ip-addresses-az"
+
+// orphanPublicAddressAzureReporter reports orphan Azure public IP addresses as
+// findings.
+var orphanPublicAddressAzureReporter = NewReporter(Kind[models.OrphanPublicAddressAzure]{
+	TaskName:     TaskReportOrphanPublicAddressAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindIPAddressAzure,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Public IP Address",
+	ArtefactName: func(item models.OrphanPublicAddressAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanPublicAddressAzure) string {
+		return fmt.Sprintf("%s:%s:%s", item.SubscriptionID, item.ResourceGroup, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanPublicAddressAzure) map[string]string {
+		return map[string]string{
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+			"location":        item.Location,
+		}
+	},
+})
+
+// HandleReportOrphanPublicAddressAzure is a handler, which reports orphan Azure
+// public IP addresses as findings.
+func HandleReportOrphanPublicAddressAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanPublicAddressAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanPublicAddressAzure,
+		asynq.HandlerFunc(HandleReportOrphanPublicAddressAzure),
+	)
+}
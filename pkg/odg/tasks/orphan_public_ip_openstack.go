@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanPublicAddressOpenStack is the name of the task, which reports
+// orphan OpenStack floating IP addresses as findings.
+const TaskReportOrphanPublicAddressOpenStack = "odg:task:report-orphan-ip-addresses-openstack"
+
+// orphanPublicAddressOpenStackReporter reports orphan OpenStack floating IP
+// addresses as findings.
+var orphanPublicAddressOpenStackReporter = NewReporter(Kind[models.OrphanPublicAddressOpenStack]{
+	TaskName:     TaskReportOrphanPublicAddressOpenStack,
+	ProviderName: apitypes.ProviderNameOpenStack,
+	ResourceKind: apitypes.ResourceKindIPAddressOpenStack,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Public IP Address",
+	ArtefactName: func(item models.OrphanPublicAddressOpenStack) string {
+		return item.FloatingIPID
+	},
+	ResourceName: func(item models.OrphanPublicAddressOpenStack) string {
+		return item.FloatingIPID
+	},
+	ArtefactExtraID: func(item models.OrphanPublicAddressOpenStack) map[string]string {
+		return map[string]string{
+			"floating_ip_id": item.FloatingIPID,
+			"project_id":     item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanPublicAddressOpenStack is a handler, which reports orphan
+// OpenStack floating IP addresses as findings.
+func HandleReportOrphanPublicAddressOpenStack(ctx context.Context, t *asynq.Task) error {
+	return orphanPublicAddressOpenStackReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanPublicAddressOpenStack,
+		asynq.HandlerFunc(HandleReportOrphanPublicAddressOpenStack),
+	)
+}
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+)
+
+// ErrNoResourceSpec is an error, which is returned by the
+// [TaskReportOrphanResources] task handler, when the payload does not describe
+// the orphan resources.
+var ErrNoResourceSpec = errors.New("no resource spec specified")
+
+// ErrInvalidResourceSpec is an error, which is returned by the
+// [TaskReportOrphanResources] task handler, when the description of the orphan
+// resources in the payload is not valid.
+var ErrInvalidResourceSpec = errors.New("invalid resource spec")
+
+// ErrInvalidResource is an error, which is returned by task handlers, when a
+// row returned by the query cannot be reported as a finding.
+var ErrInvalidResource = errors.New("invalid resource")
+
+// TaskReportOrphanResources is the name of the task, which reports orphan
+// resources of any kind as findings, as described by the payload.
+const TaskReportOrphanResources = "odg:task:report-orphan-resources"
+
+// DefaultResourceSummary is the summary of the findings reported by the
+// [TaskReportOrphanResources] task, when the payload specifies none.
+const DefaultResourceSummary = "Orphan Resource"
+
+var (
+	// reservedResourceKindsMu guards reservedResourceKinds.
+	reservedResourceKindsMu sync.RWMutex
+
+	// reservedResourceKinds maps the normalized kinds of orphan resources,
+	// which are reported by a dedicated task, to the name of that task.
+	reservedResourceKinds = make(map[string]string)
+)
+
+// normalizeResourceKind returns the given resource kind in the form used for
+// the labels of runtime artefacts, in which `/' is replaced with `_'.
+func normalizeResourceKind(resourceKind apitypes.ResourceKind) string {
+	return strings.ReplaceAll(string(resourceKind), "/", "_")
+}
+
+// reserveResourceKind reserves the given resource kind for the given task.
+func reserveResourceKind(resourceKind apitypes.ResourceKind, taskName string) {
+	reservedResourceKindsMu.Lock()
+	defer reservedResourceKindsMu.Unlock()
+
+	key := normalizeResourceKind(resourceKind)
+	if _, ok := reservedResourceKinds[key]; !ok {
+		reservedResourceKinds[key] = taskName
+	}
+}
+
+// resourceKindOwner returns the name of the task, for which the given resource
+// kind, or a resource kind colliding with it after normalization, has been
+// reserved.
+func resourceKindOwner(resourceKind apitypes.ResourceKind) (string, bool) {
+	reservedResourceKindsMu.RLock()
+	defer reservedResourceKindsMu.RUnlock()
+
+	taskName, ok := reservedResourceKinds[normalizeResourceKind(resourceKind)]
+
+	return taskName, ok
+}
+
+// ResourceSpec describes how the rows returned by the query of the
+// [TaskReportOrphanResources] task map to findings.
+type ResourceSpec struct {
+	// ProviderName specifies the name of the provider, from which the
+	// orphan resources originate from.
+	ProviderName apitypes.ProviderName `yaml:"provider_name" json:"provider_name"`
+
+	// ResourceKind specifies the kind of the orphan resources.
+	ResourceKind apitypes.ResourceKind `yaml:"resource_kind" json:"resource_kind"`
+
+	// Severity specifies the severity of the findings. Defaults to
+	// MEDIUM.
+	Severity apitypes.SeverityLevel `yaml:"severity" json:"severity"`
+
+	// Summary specifies a short summary of the findings. Defaults to
+	// [DefaultResourceSummary].
+	Summary string `yaml:"summary" json:"summary"`
+
+	// NameColumn specifies the column, which contains the unique name of
+	// the orphan resource in the provider.
+	NameColumn string `yaml:"name_column" json:"name_column"`
+
+	// ExtraIDColumns specifies the columns, which form the extra identity
+	// of the artefact, e.g. the account and region of the resource.
+	ExtraIDColumns []string `yaml:"extra_id_columns" json:"extra_id_columns"`
+
+	// AttributeColumns specifies the columns, which are included in the
+	// attributes of the findings. When empty, all columns are included.
+	AttributeColumns []string `yaml:"attribute_columns" json:"attribute_columns"`
+}
+
+// Validate verifies that the [ResourceSpec] is valid.
+func (s *ResourceSpec) Validate() error {
+	if s.ProviderName == "" {
+		return fmt.Errorf("%w: no provider name specified", ErrInvalidResourceSpec)
+	}
+
+	if s.ResourceKind == "" {
+		return fmt.Errorf("%w: no resource kind specified", ErrInvalidResourceSpec)
+	}
+
+	// The existing findings are looked up by resource kind only, and the
+	// runtime artefacts by the normalized resource kind, regardless of the
+	// provider name. Reporting a kind owned by another task would delete
+	// the findings and runtime artefacts of that task.
+	if taskName, ok := resourceKindOwner(s.ResourceKind); ok {
+		return fmt.Errorf(
+			"%w: resource kind %s is reported by %s",
+			ErrInvalidResourceSpec,
+			s.ResourceKind,
+			taskName,
+		)
+	}
+
+	if s.NameColumn == "" {
+		return fmt.Errorf("%w: no name column specified", ErrInvalidResourceSpec)
+	}
+
+	severities := []apitypes.SeverityLevel{
+		"",
+		apitypes.SeverityLevelLow,
+		apitypes.SeverityLevelMedium,
+		apitypes.SeverityLevelHigh,
+	}
+	if !slices.Contains(severities, s.Severity) {
+		return fmt.Errorf("%w: unknown severity %s", ErrInvalidResourceSpec, s.Severity)
+	}
+
+	return nil
+}
+
+// kind returns the [Kind] described by the [ResourceSpec].
+func (s *ResourceSpec) kind() Kind[map[string]any] {
+	severity := s.Severity
+	if severity == "" {
+		severity = apitypes.SeverityLevelMedium
+	}
+
+	summary := s.Summary
+	if summary == "" {
+		summary = DefaultResourceSummary
+	}
+
+	kind := Kind[map[string]any]{
+		TaskName:     TaskReportOrphanResources,
+		ProviderName: s.ProviderName,
+		ResourceKind: s.ResourceKind,
+		Severity:     severity,
+		Summary:      summary,
+		ArtefactName: func(item map[string]any) string {
+			return columnString(item, s.NameColumn)
+		},
+		ResourceName: func(item map[string]any) string {
+			return columnString(item, s.NameColumn)
+		},
+		ArtefactExtraID: func(item map[string]any) map[string]string {
+			extraID := make(map[string]string, len(s.ExtraIDColumns))
+			for _, column := range s.ExtraIDColumns {
+				extraID[column] = columnString(item, column)
+			}
+
+			return extraID
+		},
+		Attributes: func(item map[string]any) any {
+			if len(s.AttributeColumns) == 0 {
+				return item
+			}
+
+			attributes := make(map[string]any, len(s.AttributeColumns))
+			for _, column := range s.AttributeColumns {
+				attributes[column] = item[column]
+			}
+
+			return attributes
+		},
+		Validate: s.validateItem,
+	}
+
+	return kind
+}
+
+// validateItem verifies that the given row returned by the query contains the
+// columns referenced by the [ResourceSpec].
+func (s *ResourceSpec) validateItem(item map[string]any) error {
+	columns := slices.Concat([]string{s.NameColumn}, s.ExtraIDColumns, s.AttributeColumns)
+	for _, column := range columns {
+		if _, ok := item[column]; !ok {
+			return fmt.Errorf("%w: no such column %s", ErrInvalidResource, column)
+		}
+	}
+
+	if columnString(item, s.NameColumn) == "" {
+		return fmt.Errorf("%w: empty name column %s", ErrInvalidResource, s.NameColumn)
+	}
+
+	return nil
+}
+
+// columnString returns the value of the given column as a string.
+func columnString(item map[string]any, column string) string {
+	value, ok := item[column]
+	if !ok || value == nil {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
+
+// HandleReportOrphanResources is a handler, which reports orphan resources of
+// any kind as findings, as described by the [ResourceSpec] of the payload.
+func HandleReportOrphanResources(ctx context.Context, t *asynq.Task) error {
+	payload, err := DecodePayload(t)
+	if err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	if payload.Resource == nil {
+		return asynqutils.SkipRetry(ErrNoResourceSpec)
+	}
+
+	if err := payload.Resource.Validate(); err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	// The reporter is not created via NewReporter, since the kind
+	// described by the payload must not be reserved.
+	r := &Reporter[map[string]any]{
+		kind: payload.Resource.kind(),
+	}
+
+	return r.process(ctx, t, payload)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanResources,
+		asynq.HandlerFunc(HandleReportOrphanResources),
+	)
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"errors"
+	"testing"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+)
+
+func TestResourceSpecValidate(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		spec    ResourceSpec
+		wantErr error
+	}{
+		{
+			desc: "new resource kind",
+			spec: ResourceSpec{
+				ProviderName: apitypes.ProviderNameAWS,
+				ResourceKind: "aws/network-interface",
+				NameColumn:   "network_interface_id",
+			},
+		},
+		{
+			desc: "resource kind of dedicated task",
+			spec: ResourceSpec{
+				ProviderName: apitypes.ProviderNameAWS,
+				ResourceKind: apitypes.ResourceKindVirtualMachineAWS,
+				NameColumn:   "instance_id",
+			},
+			wantErr: ErrInvalidResourceSpec,
+		},
+		{
+			desc: "resource kind of dedicated task with another provider",
+			spec: ResourceSpec{
+				ProviderName: apitypes.ProviderNameGCP,
+				ResourceKind: apitypes.ResourceKindVirtualMachineAWS,
+				NameColumn:   "instance_id",
+			},
+			wantErr: ErrInvalidResourceSpec,
+		},
+		{
+			desc: "resource kind colliding after normalization",
+			spec: ResourceSpec{
+				ProviderName: apitypes.ProviderNameAWS,
+				ResourceKind: "aws_virtual-machine",
+				NameColumn:   "instance_id",
+			},
+			wantErr: ErrInvalidResourceSpec,
+		},
+		{
+			desc: "no name column",
+			spec: ResourceSpec{
+				ProviderName: apitypes.ProviderNameAWS,
+				ResourceKind: "aws/network-interface",
+			},
+			wantErr: ErrInvalidResourceSpec,
+		},
+		{
+			desc: "unknown severity",
+			spec: ResourceSpec{
+				ProviderName: apitypes.ProviderNameAWS,
+				ResourceKind: "aws/network-interface",
+				NameColumn:   "network_interface_id",
+				Severity:     "CRITICAL",
+			},
+			wantErr: ErrInvalidResourceSpec,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.spec.Validate()
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanSecurityGroupsAWS is the name of the task, which reports
+// orphan AWS security groups as findings.
+const TaskReportOrphanSecurityGroupsAWS = "odg:task:report-orphan-security-groups-aws"
+
+// orphanSecurityGroupsAWSReporter reports orphan AWS security groups as
+// findings.
+var orphanSecurityGroupsAWSReporter = NewReporter(Kind[models.OrphanSecurityGroupAWS]{
+	TaskName:     TaskReportOrphanSecurityGroupsAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindSecurityGroupAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Security Group",
+	ArtefactName: func(item models.OrphanSecurityGroupAWS) string {
+		return item.GroupID
+	},
+	ResourceName: func(item models.OrphanSecurityGroupAWS) string {
+		return item.GroupID
+	},
+	ArtefactExtraID: func(item models.OrphanSecurityGroupAWS) map[string]string {
+		return map[string]string{
+			"group_id":    item.GroupID,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanSecurityGroupsAWS is a handler, which reports orphan AWS
+// security groups as findings.
+func HandleReportOrphanSecurityGroupsAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanSecurityGroupsAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanSecurityGroupsAWS,
+		asynq.HandlerFunc(HandleReportOrphanSecurityGroupsAWS),
+	)
+}
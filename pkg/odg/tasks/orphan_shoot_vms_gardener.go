@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanShootVirtualMachinesGardener is the name of the task, which
+// reports orphan virtual machines of Gardener shoots, which no longer exist, as
+// findings.
+const TaskReportOrphanShootVirtualMachinesGardener = "odg:task:report-orphan-shoot-vms-gardener"
+
+// orphanShootVirtualMachinesGardenerReporter reports orphan virtual machines of
+// Gardener shoots, which no longer exist, as findings.
+var orphanShootVirtualMachinesGardenerReporter = NewReporter(Kind[models.OrphanShootVirtualMachineGardener]{
+	TaskName:     TaskReportOrphanShootVirtualMachinesGardener,
+	ProviderName: apitypes.ProviderNameGardener,
+	ResourceKind: apitypes.ResourceKindShootVirtualMachineGardener,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Shoot Virtual Machine",
+	ArtefactName: func(item models.OrphanShootVirtualMachineGardener) string {
+		return item.InstanceID
+	},
+	ResourceName: func(item models.OrphanShootVirtualMachineGardener) string {
+		return fmt.Sprintf("%s:%s:%s", item.ProviderType, item.Account, item.InstanceID)
+	},
+	ArtefactExtraID: func(item models.OrphanShootVirtualMachineGardener) map[string]string {
+		return map[string]string{
+			"provider_type": item.ProviderType,
+			"account":       item.Account,
+			"region":        item.Region,
+			"instance_id":   item.InstanceID,
+		}
+	},
+})
+
+// HandleReportOrphanShootVirtualMachinesGardener is a handler, which reports
+// orphan virtual machines of Gardener shoots, which no longer exist, as
+// findings.
+func HandleReportOrphanShootVirtualMachinesGardener(ctx context.Context, t *asynq.Task) error {
+	return orphanShootVirtualMachinesGardenerReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanShootVirtualMachinesGardener,
+		asynq.HandlerFunc(HandleReportOrphanShootVirtualMachinesGardener),
+	)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanSnapshotsAWS is the name of the task, which reports orphan
+// AWS EBS snapshots as findings.
+const TaskReportOrphanSnapshotsAWS = "odg:task:report-orphan-snapshots-aws"
+
+// orphanSnapshotsAWSReporter reports orphan AWS EBS snapshots as findings.
+var orphanSnapshotsAWSReporter = NewReporter(Kind[models.OrphanSnapshotAWS]{
+	TaskName:     TaskReportOrphanSnapshotsAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindSnapshotAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Snapshot",
+	ArtefactName: func(item models.OrphanSnapshotAWS) string {
+		return item.SnapshotID
+	},
+	ResourceName: func(item models.OrphanSnapshotAWS) string {
+		return item.SnapshotID
+	},
+	ArtefactExtraID: func(item models.OrphanSnapshotAWS) map[string]string {
+		return map[string]string{
+			"snapshot_id": item.SnapshotID,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanSnapshotsAWS is a handler, which reports orphan AWS EBS
+// snapshots as findings.
+func HandleReportOrphanSnapshotsAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanSnapshotsAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanSnapshotsAWS,
+		asynq.HandlerFunc(HandleReportOrphanSnapshotsAWS),
+	)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanSnapshotsAzure is the name of the task, which reports orphan
+// Azure disk snapshots as findings.
+const TaskReportOrphanSnapshotsAzure = "odg:task:report-orphan-snapshots-az"
+
+// orphanSnapshotsAzureReporter reports orphan Azure disk snapshots as findings.
+var orphanSnapshotsAzureReporter = NewReporter(Kind[models.OrphanSnapshotAzure]{
+	TaskName:     TaskReportOrphanSnapshotsAzure,
+	ProviderName: apitypes.ProviderNameAzure,
+	ResourceKind: apitypes.ResourceKindSnapshotAzure,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Snapshot",
+	ArtefactName: func(item models.OrphanSnapshotAzure) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanSnapshotAzure) string {
+		return fmt.Sprintf("%s:%s:%s", item.SubscriptionID, item.ResourceGroup, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanSnapshotAzure) map[string]string {
+		return map[string]string{
+			"snapshot_name":   item.Name,
+			"subscription_id": item.SubscriptionID,
+			"resource_group":  item.ResourceGroup,
+		}
+	},
+})
+
+// HandleReportOrphanSnapshotsAzure is a handler, which reports orphan Azure
+// disk snapshots as findings.
+func HandleReportOrphanSnapshotsAzure(ctx context.Context, t *asynq.Task) error {
+	return orphanSnapshotsAzureReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanSnapshotsAzure,
+		asynq.HandlerFunc(HandleReportOrphanSnapshotsAzure),
+	)
+}
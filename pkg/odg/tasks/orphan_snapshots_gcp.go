@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanSnapshotsGCP is the name of the task, which reports orphan
+// GCP disk snapshots as findings.
+const TaskReportOrphanSnapshotsGCP = "odg:task:report-orphan-snapshots-gcp"
+
+// orphanSnapshotsGCPReporter reports orphan GCP disk snapshots as findings.
+var orphanSnapshotsGCPReporter = NewReporter(Kind[models.OrphanSnapshotGCP]{
+	TaskName:     TaskReportOrphanSnapshotsGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindSnapshotGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Snapshot",
+	ArtefactName: func(item models.OrphanSnapshotGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanSnapshotGCP) string {
+		return fmt.Sprintf("%s:%s", item.ProjectID, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanSnapshotGCP) map[string]string {
+		return map[string]string{
+			"snapshot_name": item.Name,
+			"project_id":    item.ProjectID,
+		}
+	},
+})
+
+// HandleReportOrphanSnapshotsGCP is a handler, which reports orphan GCP disk
+// snapshots as findings.
+func HandleReportOrphanSnapshotsGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanSnapshotsGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanSnapshotsGCP,
+		asynq.HandlerFunc(HandleReportOrphanSnapshotsGCP),
+	)
+}
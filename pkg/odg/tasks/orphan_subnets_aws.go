@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanSubnetsAWS is the name of the task, which reports orphan AWS
+// subnets as findings.
+const TaskReportOrphanSubnetsAWS = "odg:task:report-orphan-subnets-aws"
+
+// orphanSubnetsAWSReporter reports orphan AWS subnets as findings.
+var orphanSubnetsAWSReporter = NewReporter(Kind[models.OrphanSubnetAWS]{
+	TaskName:     TaskReportOrphanSubnetsAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindSubnetAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Subnet",
+	ArtefactName: func(item models.OrphanSubnetAWS) string {
+		return item.SubnetID
+	},
+	ResourceName: func(item models.OrphanSubnetAWS) string {
+		return item.SubnetID
+	},
+	ArtefactExtraID: func(item models.OrphanSubnetAWS) map[string]string {
+		return map[string]string{
+			"subnet_id":   item.SubnetID,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanSubnetsAWS is a handler, which reports orphan AWS subnets
+// as findings.
+func HandleReportOrphanSubnetsAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanSubnetsAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanSubnetsAWS,
+		asynq.HandlerFunc(HandleReportOrphanSubnetsAWS),
+	)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanSubnetsGCP is the name of the task, which reports orphan GCP
+// subnets as findings.
+const TaskReportOrphanSubnetsGCP = "odg:task:report-orphan-subnets-gcp"
+
+// orphanSubnetsGCPReporter reports orphan GCP subnets as findings.
+var orphanSubnetsGCPReporter = NewReporter(Kind[models.OrphanSubnetGCP]{
+	TaskName:     TaskReportOrphanSubnetsGCP,
+	ProviderName: apitypes.ProviderNameGCP,
+	ResourceKind: apitypes.ResourceKindSubnetGCP,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan Subnet",
+	ArtefactName: func(item models.OrphanSubnetGCP) string {
+		return item.Name
+	},
+	ResourceName: func(item models.OrphanSubnetGCP) string {
+		return fmt.Sprintf("%s:%s:%s", item.ProjectID, item.Region, item.Name)
+	},
+	ArtefactExtraID: func(item models.OrphanSubnetGCP) map[string]string {
+		return map[string]string{
+			"subnet_name": item.Name,
+			"project_id":  item.ProjectID,
+			"region":      item.Region,
+		}
+	},
+})
+
+// HandleReportOrphanSubnetsGCP is a handler, which reports orphan GCP subnets
+// as findings.
+func HandleReportOrphanSubnetsGCP(ctx context.Context, t *asynq.Task) error {
+	return orphanSubnetsGCPReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanSubnetsGCP,
+		asynq.HandlerFunc(HandleReportOrphanSubnetsGCP),
+	)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanVolumesAWS is the name of the task, which reports orphan AWS
+// EBS volumes as findings.
+const TaskReportOrphanVolumesAWS = "odg:task:report-orphan-volumes-aws"
+
+// orphanVolumesAWSReporter reports orphan AWS EBS volumes as findings.
+var orphanVolumesAWSReporter = NewReporter(Kind[models.OrphanVolumeAWS]{
+	TaskName:     TaskReportOrphanVolumesAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindVolumeAWS,
+	Severity:     apitypes.SeverityLevelHigh,
+	Summary:      "Orphan Volume",
+	ArtefactName: func(item models.OrphanVolumeAWS) string {
+		return item.VolumeID
+	},
+	ResourceName: func(item models.OrphanVolumeAWS) string {
+		return item.VolumeID
+	},
+	ArtefactExtraID: func(item models.OrphanVolumeAWS) map[string]string {
+		return map[string]string{
+			"volume_id":   item.VolumeID,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanVolumesAWS is a handler, which reports orphan AWS EBS
+// volumes as findings.
+func HandleReportOrphanVolumesAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanVolumesAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanVolumesAWS,
+		asynq.HandlerFunc(HandleReportOrphanVolumesAWS),
+	)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+
+	"github.com/gardener/inventory/pkg/core/registry"
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	"github.com/gardener/inventory-extension-odg/pkg/odg/models"
+)
+
+// TaskReportOrphanVPCsAWS is the name of the task, which reports orphan AWS
+// VPCs as findings.
+const TaskReportOrphanVPCsAWS = "odg:task:report-orphan-vpcs-aws"
+
+// orphanVPCsAWSReporter reports orphan AWS VPCs as findings.
+var orphanVPCsAWSReporter = NewReporter(Kind[models.OrphanVPCAWS]{
+	TaskName:     TaskReportOrphanVPCsAWS,
+	ProviderName: apitypes.ProviderNameAWS,
+	ResourceKind: apitypes.ResourceKindVPCAWS,
+	Severity:     apitypes.SeverityLevelMedium,
+	Summary:      "Orphan VPC",
+	ArtefactName: func(item models.OrphanVPCAWS) string {
+		return item.VpcID
+	},
+	ResourceName: func(item models.OrphanVPCAWS) string {
+		return item.VpcID
+	},
+	ArtefactExtraID: func(item models.OrphanVPCAWS) map[string]string {
+		return map[string]string{
+			"vpc_id":      item.VpcID,
+			"region_name": item.RegionName,
+			"account_id":  item.AccountID,
+		}
+	},
+})
+
+// HandleReportOrphanVPCsAWS is a handler, which reports orphan AWS VPCs as
+// findings.
+func HandleReportOrphanVPCsAWS(ctx context.Context, t *asynq.Task) error {
+	return orphanVPCsAWSReporter.ProcessTask(ctx, t)
+}
+
+// init registers the task handlers with the default Inventory registry
+func init() {
+	registry.TaskRegistry.MustRegister(
+		TaskReportOrphanVPCsAWS,
+		asynq.HandlerFunc(HandleReportOrphanVPCsAWS),
+	)
+}
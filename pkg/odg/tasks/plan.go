@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+)
+
+// PlanItems represents a group of items in a [Plan].
+type PlanItems struct {
+	// Count specifies the number of items.
+	Count int `json:"count"`
+
+	// IDs specifies the identifiers of the items.
+	IDs []string `json:"ids"`
+}
+
+// newPlanItems creates a new [PlanItems] from the given items, using the
+// provided function to get the identifier of each item.
+func newPlanItems[T any](items []T, idFunc func(item T) string) PlanItems {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, idFunc(item))
+	}
+
+	p := PlanItems{
+		Count: len(ids),
+		IDs:   ids,
+	}
+
+	return p
+}
+
+// Plan describes the changes, which a task handler applies to the Delivery
+// Service when reporting orphan resources.
+type Plan struct {
+	// DryRun specifies whether the plan was computed in dry-run mode, in
+	// which case no changes have been applied.
+	DryRun bool `json:"dry_run"`
+
+	// ProviderName specifies the name of the provider of the orphan
+	// resources.
+	ProviderName apitypes.ProviderName `json:"provider_name"`
+
+	// ResourceKind specifies the kind of the orphan resources.
+	ResourceKind apitypes.ResourceKind `json:"resource_kind"`
+
+	// ComponentName specifies the name of the OCM component with which
+	// findings are associated.
+	ComponentName string `json:"component_name"`
+
+	// ComponentVersion specifies the version of the OCM component with
+	// which findings are associated.
+	ComponentVersion string `json:"component_version"`
+
+	// Discovered specifies the number of orphan resources discovered in
+	// Inventory.
+	Discovered int `json:"discovered"`
+
+	// ExistingFindings specifies the number of findings, which exist in
+	// the Delivery Service.
+	ExistingFindings int `json:"existing_findings"`
+
+	// FindingsToDelete specifies the findings, which are deleted, since
+	// the resources are no longer orphan.
+	FindingsToDelete PlanItems `json:"findings_to_delete"`
+
+	// FindingsToCreate specifies the findings for newly discovered
+	// orphan resources.
+	FindingsToCreate PlanItems `json:"findings_to_create"`
+
+	// FindingsToUpdate specifies the existing findings, whose data has
+	// changed.
+	FindingsToUpdate PlanItems `json:"findings_to_update"`
+
+	// ExistingRuntimeArtefacts specifies the number of runtime artefacts,
+	// which exist in the Delivery Service.
+	ExistingRuntimeArtefacts int `json:"existing_runtime_artefacts"`
+
+	// RuntimeArtefactsToDelete specifies the names of the runtime
+	// artefacts, which are deleted.
+	RuntimeArtefactsToDelete PlanItems `json:"runtime_artefacts_to_delete"`
+
+	// RuntimeArtefactsToCreate specifies the runtime artefacts, which are
+	// created.
+	RuntimeArtefactsToCreate PlanItems `json:"runtime_artefacts_to_create"`
+
+	// Guardrail specifies the reason for which the mass deletion
+	// [Guardrail] has been triggered, if any.
+	Guardrail string `json:"guardrail,omitempty"`
+}
+
+// changeset represents the changes, which are applied to the Delivery Service
+// when reporting orphan resources.
+type changeset struct {
+	// existingFindings is the number of findings, which exist in the
+	// Delivery Service.
+	existingFindings int
+
+	// findingsToDelete are the existing findings, which are deleted.
+	findingsToDelete []apitypes.ArtefactMetadata
+
+	// findingsCreated are the identifiers of the findings, which are
+	// created.
+	findingsCreated []string
+
+	// findingsUpdated are the identifiers of the findings, which are
+	// updated.
+	findingsUpdated []string
+
+	// scanTime is the time at which the orphan resources have been
+	// fetched from Inventory.
+	scanTime time.Time
+
+	// existingRuntimeArtefacts is the number of runtime artefacts, which
+	// exist in the Delivery Service.
+	existingRuntimeArtefacts int
+
+	// runtimeArtefactsToDelete are the existing runtime artefacts, which
+	// are deleted.
+	runtimeArtefactsToDelete []apitypes.RuntimeArtefactResultItem
+
+	// runtimeArtefactsCreated are the identifiers of the runtime
+	// artefacts, which are created.
+	runtimeArtefactsCreated []string
+}
+
+// findingID returns the identifier of the given finding for use in a [Plan].
+func findingID(item apitypes.ArtefactMetadata) string {
+	return item.Data.ResourceName
+}
+
+// runtimeArtefactID returns the identifier of the given runtime artefact for
+// use in a [Plan].
+func runtimeArtefactID(item apitypes.ComponentArtefactID) string {
+	return item.Artefact.ArtefactName
+}
+
+// runtimeArtefactName returns the name of the given existing runtime artefact.
+func runtimeArtefactName(item apitypes.RuntimeArtefactResultItem) string {
+	return item.Metadata.Name
+}
+
+// planID returns the given identifier for use in a [Plan].
+func planID(id string) string {
+	return id
+}
+
+// plan returns the [Plan] describing the changeset.
+func (c *changeset) plan() Plan {
+	p := Plan{
+		ExistingFindings:         c.existingFindings,
+		FindingsToDelete:         newPlanItems(c.findingsToDelete, findingID),
+		FindingsToCreate:         newPlanItems(c.findingsCreated, planID),
+		FindingsToUpdate:         newPlanItems(c.findingsUpdated, planID),
+		ExistingRuntimeArtefacts: c.existingRuntimeArtefacts,
+		RuntimeArtefactsToDelete: newPlanItems(c.runtimeArtefactsToDelete, runtimeArtefactName),
+		RuntimeArtefactsToCreate: newPlanItems(c.runtimeArtefactsCreated, planID),
+	}
+
+	return p
+}
+
+// writePlan writes the given [Plan] as the result of the [asynq.Task], so that
+// it can be inspected once the task completes.
+func writePlan(t *asynq.Task, plan Plan) error {
+	w := t.ResultWriter()
+	if w == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+
+	return err
+}
@@ -0,0 +1,9 @@
+SELECT
+  b.name,
+  b.region_name,
+  b.account_id,
+  b.creation_time,
+  b.public_access
+FROM aws_orphan_bucket AS b
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:bucket')
@@ -0,0 +1,12 @@
+SELECT
+  b.name,
+  b.storage_account,
+  b.subscription_id,
+  b.resource_group,
+  b.location,
+  b.public_access_level,
+  b.creation_time,
+  b.public_access
+FROM az_orphan_blob_container AS b
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:blob_container')
@@ -0,0 +1,11 @@
+SELECT
+  b.name,
+  b.project_id,
+  b.location,
+  b.location_type,
+  b.storage_class,
+  b.creation_time,
+  b.public_access
+FROM gcp_orphan_bucket AS b
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:bucket')
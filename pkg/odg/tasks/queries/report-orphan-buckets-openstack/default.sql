@@ -0,0 +1,12 @@
+SELECT
+  c.name,
+  c.project_id,
+  c.domain,
+  c.region,
+  c.object_count,
+  c.bytes_used,
+  c.creation_time,
+  c.public_access
+FROM openstack_orphan_container AS c
+WHERE
+  housekeeper_ran_in_last('1 hour', 'openstack:model:container')
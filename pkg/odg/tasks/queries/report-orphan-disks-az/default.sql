@@ -0,0 +1,13 @@
+SELECT
+  d.name,
+  d.subscription_id,
+  d.resource_group,
+  d.location,
+  d.disk_state,
+  d.disk_size_gb,
+  d.sku_name,
+  d.provisioning_state,
+  d.time_created
+FROM az_orphan_disk AS d
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:disk')
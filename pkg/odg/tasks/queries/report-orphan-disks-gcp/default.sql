@@ -0,0 +1,16 @@
+SELECT
+  d.name,
+  d.project_id,
+  d.zone,
+  d.region,
+  d.type,
+  d.size_gb,
+  d.status,
+  d.description,
+  d.is_regional,
+  d.creation_timestamp,
+  d.last_attach_timestamp,
+  d.last_detach_timestamp
+FROM gcp_orphan_disk AS d
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:disk')
@@ -0,0 +1,12 @@
+SELECT
+  r.zone_id,
+  r.zone_name,
+  r.name,
+  r.type,
+  r.ttl,
+  r.target,
+  r.account_id,
+  r.dangling
+FROM aws_orphan_dns_record AS r
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:dns_record')
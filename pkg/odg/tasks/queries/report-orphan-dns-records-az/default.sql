@@ -0,0 +1,12 @@
+SELECT
+  r.zone_name,
+  r.name,
+  r.type,
+  r.ttl,
+  r.target,
+  r.subscription_id,
+  r.resource_group,
+  r.dangling
+FROM az_orphan_dns_record AS r
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:dns_record')
@@ -0,0 +1,12 @@
+SELECT
+  r.managed_zone,
+  r.zone_name,
+  r.name,
+  r.type,
+  r.ttl,
+  r.target,
+  r.project_id,
+  r.dangling
+FROM gcp_orphan_dns_record AS r
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:dns_record')
@@ -0,0 +1,12 @@
+SELECT
+  f.name,
+  f.project_id,
+  f.network,
+  f.direction,
+  f.priority,
+  f.disabled,
+  f.description,
+  f.creation_timestamp
+FROM gcp_orphan_firewall AS f
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:firewall')
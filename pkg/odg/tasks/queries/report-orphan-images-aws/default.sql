@@ -0,0 +1,14 @@
+SELECT
+  i.image_id,
+  i.name,
+  i.owner_id,
+  i.image_type,
+  i.root_device_type,
+  i.description,
+  i.state,
+  i.region_name,
+  i.account_id,
+  i.creation_date
+FROM aws_orphan_image AS i
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:image')
@@ -0,0 +1,12 @@
+SELECT
+  i.name,
+  i.subscription_id,
+  i.resource_group,
+  i.location,
+  i.os_type,
+  i.hyper_v_gen,
+  i.provisioning_state,
+  i.time_created
+FROM az_orphan_image AS i
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:image')
@@ -0,0 +1,12 @@
+SELECT
+  i.name,
+  i.project_id,
+  i.family,
+  i.status,
+  i.disk_size_gb,
+  i.archive_size_bytes,
+  i.description,
+  i.creation_timestamp
+FROM gcp_orphan_image AS i
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:image')
@@ -0,0 +1,15 @@
+SELECT
+  a.allocation_id,
+  a.public_ip,
+  a.private_ip_address,
+  a.domain,
+  a.association_id,
+  a.instance_id,
+  a.network_interface_id,
+  a.public_ipv4_pool,
+  a.network_border_group,
+  a.region_name,
+  a.account_id
+FROM aws_orphan_public_address AS a
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:elastic_ip')
@@ -0,0 +1,14 @@
+SELECT
+  a.name,
+  a.subscription_id,
+  a.resource_group,
+  a.location,
+  a.ip_address,
+  a.ip_version,
+  a.sku_name,
+  a.sku_tier,
+  a.provisioning_state,
+  a.fqdn
+FROM az_orphan_public_address AS a
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:public_address')
@@ -0,0 +1,17 @@
+SELECT
+  f.floating_ip_id,
+  f.floating_ip,
+  f.fixed_ip,
+  f.project_id,
+  f.domain,
+  f.region,
+  f.port_id,
+  f.router_id,
+  f.floating_network_id,
+  f.status,
+  f.description,
+  f.ip_created_at,
+  f.ip_updated_at
+FROM openstack_orphan_floating_ip AS f
+WHERE
+  housekeeper_ran_in_last('1 hour', 'openstack:model:floating_ip')
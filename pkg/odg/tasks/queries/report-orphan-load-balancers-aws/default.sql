@@ -0,0 +1,15 @@
+SELECT
+  lb.name,
+  lb.arn,
+  lb.dns_name,
+  lb.type,
+  lb.scheme,
+  lb.state,
+  lb.vpc_id,
+  lb.region_name,
+  lb.account_id,
+  lb.created_time,
+  lb.listeners
+FROM aws_orphan_load_balancer AS lb
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:loadbalancer')
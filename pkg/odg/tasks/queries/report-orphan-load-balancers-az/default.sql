@@ -0,0 +1,11 @@
+SELECT
+  lb.name,
+  lb.subscription_id,
+  lb.resource_group,
+  lb.location,
+  lb.sku_name,
+  lb.provisioning_state,
+  lb.frontends
+FROM az_orphan_load_balancer AS lb
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:loadbalancer')
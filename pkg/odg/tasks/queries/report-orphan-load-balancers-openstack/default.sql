@@ -0,0 +1,17 @@
+SELECT
+  lb.load_balancer_id,
+  lb.name,
+  lb.project_id,
+  lb.domain,
+  lb.region,
+  lb.provider,
+  lb.vip_address,
+  lb.vip_subnet_id,
+  lb.operating_status,
+  lb.provisioning_status,
+  lb.lb_created_at,
+  lb.lb_updated_at,
+  lb.listeners
+FROM openstack_orphan_loadbalancer AS lb
+WHERE
+  housekeeper_ran_in_last('1 hour', 'openstack:model:loadbalancer')
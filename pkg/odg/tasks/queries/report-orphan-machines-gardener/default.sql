@@ -0,0 +1,11 @@
+SELECT
+  m.name,
+  m.namespace,
+  m.seed_name,
+  m.provider_id,
+  m.status,
+  m.node,
+  m.creation_timestamp
+FROM g_orphan_machine AS m
+WHERE
+  housekeeper_ran_in_last('1 hour', 'g:model:machine')
@@ -0,0 +1,10 @@
+SELECT
+  n.name,
+  n.project_id,
+  n.description,
+  n.auto_create_subnetworks,
+  n.routing_mode,
+  n.creation_timestamp
+FROM gcp_orphan_vpc AS n
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:vpc')
@@ -0,0 +1,11 @@
+SELECT
+  sg.group_id,
+  sg.name,
+  sg.description,
+  sg.vpc_id,
+  sg.vpc_name,
+  sg.region_name,
+  sg.account_id
+FROM aws_orphan_security_group AS sg
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:security_group')
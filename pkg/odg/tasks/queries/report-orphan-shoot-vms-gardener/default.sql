@@ -0,0 +1,12 @@
+SELECT
+  vm.name,
+  vm.instance_id,
+  vm.provider_type,
+  vm.account,
+  vm.region,
+  vm.technical_id,
+  vm.project_name,
+  vm.shoot_name
+FROM g_orphan_shoot_vm AS vm
+WHERE
+  housekeeper_ran_in_last('1 hour', 'g:model:shoot')
@@ -0,0 +1,13 @@
+SELECT
+  s.snapshot_id,
+  s.volume_id,
+  s.volume_size,
+  s.state,
+  s.encrypted,
+  s.description,
+  s.region_name,
+  s.account_id,
+  s.start_time
+FROM aws_orphan_snapshot AS s
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:snapshot')
@@ -0,0 +1,12 @@
+SELECT
+  s.name,
+  s.subscription_id,
+  s.resource_group,
+  s.location,
+  s.disk_size_gb,
+  s.source_resource_id,
+  s.incremental,
+  s.time_created
+FROM az_orphan_snapshot AS s
+WHERE
+  housekeeper_ran_in_last('1 hour', 'az:model:snapshot')
@@ -0,0 +1,12 @@
+SELECT
+  s.name,
+  s.project_id,
+  s.source_disk,
+  s.disk_size_gb,
+  s.storage_bytes,
+  s.status,
+  s.description,
+  s.creation_timestamp
+FROM gcp_orphan_snapshot AS s
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:snapshot')
@@ -0,0 +1,13 @@
+SELECT
+  s.subnet_id,
+  s.name,
+  s.vpc_id,
+  s.vpc_name,
+  s.cidr_block,
+  s.availability_zone,
+  s.state,
+  s.region_name,
+  s.account_id
+FROM aws_orphan_subnet AS s
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:subnet')
@@ -0,0 +1,13 @@
+SELECT
+  s.name,
+  s.project_id,
+  s.region,
+  s.network,
+  s.ip_cidr_range,
+  s.gateway,
+  s.purpose,
+  s.description,
+  s.creation_timestamp
+FROM gcp_orphan_subnet AS s
+WHERE
+  housekeeper_ran_in_last('1 hour', 'gcp:model:subnet')
@@ -0,0 +1,16 @@
+SELECT
+  s.server_id,
+  s.name,
+  s.project_id,
+  s.project_name,
+  s.domain,
+  s.region,
+  s.user_id,
+  s.availability_zone,
+  s.status,
+  s.image_id,
+  s.server_created_at,
+  s.server_updated_at
+FROM openstack_orphan_server AS s
+WHERE
+  housekeeper_ran_in_last('1 hour', 'openstack:model:server')
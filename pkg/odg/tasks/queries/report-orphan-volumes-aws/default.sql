@@ -0,0 +1,15 @@
+SELECT
+  v.volume_id,
+  v.name,
+  v.region_name,
+  v.account_id,
+  v.availability_zone,
+  v.volume_type,
+  v.size,
+  v.state,
+  v.encrypted,
+  v.snapshot_id,
+  v.create_time
+FROM aws_orphan_volume AS v
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:volume')
@@ -0,0 +1,11 @@
+SELECT
+  v.vpc_id,
+  v.name,
+  v.cidr_block,
+  v.state,
+  v.is_default,
+  v.region_name,
+  v.account_id
+FROM aws_orphan_vpc AS v
+WHERE
+  housekeeper_ran_in_last('1 hour', 'aws:model:vpc')
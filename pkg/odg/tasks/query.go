@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/uptrace/bun"
+)
+
+// ErrQueryNotAllowed is an error, which is returned by task handlers, when the
+// query provided as part of the payload is not a single SELECT statement.
+var ErrQueryNotAllowed = errors.New("query not allowed")
+
+// QueryLimits specifies the limits, which apply when executing the queries
+// for orphan resources against the Inventory database.
+//
+// Queries are always executed in a read-only transaction.
+type QueryLimits struct {
+	// StatementTimeout specifies the max amount of time a query may run
+	// for. A value of zero means no timeout, unless one is configured for
+	// the database user.
+	StatementTimeout time.Duration
+
+	// Role specifies the database role to assume before executing a query,
+	// which allows executing queries with less privileges than the ones of
+	// the database user. An empty value means no role is assumed.
+	//
+	// The role is not a security boundary, since a query may reset it on
+	// its own, e.g. via set_config(). The database user itself should be
+	// restricted to read-only access instead.
+	Role string
+
+	// MaxRows specifies the max number of rows a query may return, unless
+	// the payload specifies a limit on its own. A value of zero means no
+	// limit.
+	MaxRows int
+}
+
+// DefaultQueryLimits is the [QueryLimits] used by the task handlers.
+var DefaultQueryLimits = QueryLimits{
+	StatementTimeout: 5 * time.Minute,
+}
+
+// SetDefaultQueryLimits sets the default [QueryLimits] used by the task
+// handlers.
+func SetDefaultQueryLimits(l QueryLimits) {
+	DefaultQueryLimits = l
+}
+
+// ValidateQuery verifies that the given query consists of a single SELECT
+// statement, which may be preceded by common table expressions.
+//
+// This is only a safeguard for rejecting unexpected queries early. Queries are
+// executed as prepared statements in a read-only transaction, so that the
+// database server refuses multiple statements on its own, which could
+// otherwise end the read-only transaction.
+func ValidateQuery(query string) error {
+	statements := make([]string, 0)
+	for _, statement := range strings.Split(stripQuery(query), ";") {
+		statement = strings.TrimSpace(statement)
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+
+	if len(statements) == 0 {
+		return ErrNoQuery
+	}
+
+	if len(statements) > 1 {
+		return fmt.Errorf("%w: multiple statements", ErrQueryNotAllowed)
+	}
+
+	end := strings.IndexFunc(statements[0], func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if end == -1 {
+		end = len(statements[0])
+	}
+
+	keyword := strings.ToUpper(statements[0][:end])
+	switch keyword {
+	case "SELECT", "WITH":
+		return nil
+	case "":
+		return fmt.Errorf("%w: not a select statement", ErrQueryNotAllowed)
+	default:
+		return fmt.Errorf("%w: %s statement", ErrQueryNotAllowed, keyword)
+	}
+}
+
+// stripQuery returns the given query with comments replaced by whitespace, and
+// with the contents of string literals, quoted identifiers and dollar-quoted
+// strings removed, so that the result can be inspected for statements.
+func stripQuery(query string) string {
+	var sb strings.Builder
+	sb.Grow(len(query))
+
+	for i := 0; i < len(query); {
+		rest := query[i:]
+		switch {
+		case isEscapeStringPrefix(query, i):
+			// Escape string constants, e.g. E'it\'s', in which
+			// quotes may be escaped with a backslash.
+			end := escapeStringEnd(rest[1:])
+			sb.WriteString("''")
+			i += end + 1
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end == -1 {
+				end = len(rest)
+			}
+			sb.WriteByte(' ')
+			i += end
+		case strings.HasPrefix(rest, "/*"):
+			// Block comments may be nested in PostgreSQL
+			depth, j := 0, 0
+			for j < len(rest) {
+				switch {
+				case strings.HasPrefix(rest[j:], "/*"):
+					depth++
+					j += 2
+				case strings.HasPrefix(rest[j:], "*/"):
+					depth--
+					j += 2
+				default:
+					j++
+				}
+				if depth == 0 {
+					break
+				}
+			}
+			sb.WriteByte(' ')
+			i += j
+		case rest[0] == '\'' || rest[0] == '"':
+			// Quotes are escaped by doubling them, which is
+			// equivalent to two adjacent quoted strings here.
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			sb.WriteByte(rest[0])
+			sb.WriteByte(rest[0])
+			i += end + 2
+		case rest[0] == '$':
+			tag := dollarQuoteTag(rest)
+			if tag == "" {
+				sb.WriteByte(rest[0])
+				i++
+
+				continue
+			}
+			end := strings.Index(rest[len(tag):], tag)
+			if end == -1 {
+				end = len(rest) - len(tag)
+			}
+			sb.WriteString("''")
+			i += end + 2*len(tag)
+		default:
+			sb.WriteByte(rest[0])
+			i++
+		}
+	}
+
+	return sb.String()
+}
+
+// isEscapeStringPrefix returns true, if the given query contains the prefix of
+// an escape string constant at the given position, i.e. an E, which is
+// immediately followed by a quote and is not part of an identifier.
+func isEscapeStringPrefix(query string, i int) bool {
+	if query[i] != 'E' && query[i] != 'e' {
+		return false
+	}
+
+	if i+1 >= len(query) || query[i+1] != '\'' {
+		return false
+	}
+
+	if i == 0 {
+		return true
+	}
+
+	c := query[i-1]
+	identifier := c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+
+	return !identifier
+}
+
+// escapeStringEnd returns the length of the escape string constant, which the
+// given string starts with, including its quotes. Quotes are escaped either
+// by doubling them, or with a backslash. Unterminated strings extend to the
+// end of the given string.
+func escapeStringEnd(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '\'':
+			if i+1 < len(s) && s[i+1] == '\'' {
+				i++
+
+				continue
+			}
+
+			return i + 1
+		}
+	}
+
+	return len(s)
+}
+
+// dollarQuoteTag returns the opening tag of the dollar-quoted string, which the
+// given string starts with, e.g. `$$' or `$tag$'. It returns an empty string, if
+// the given string does not start with a dollar-quoted string.
+func dollarQuoteTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '$':
+			return s[:i+1]
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			continue
+		case c >= '0' && c <= '9' && i > 1:
+			continue
+		default:
+			return ""
+		}
+	}
+
+	return ""
+}
+
+// prepareQuery prepares the given query in the given transaction, after binding
+// the named args of the given [bun.DB] to its placeholders.
+//
+// Prepared statements are sent using the extended query protocol, for which
+// the database server refuses multiple statements, regardless of whether
+// [ValidateQuery] recognizes them.
+func prepareQuery(ctx context.Context, db *bun.DB, tx bun.Tx, query string) (*sql.Stmt, error) {
+	return tx.Tx.PrepareContext(ctx, db.Formatter().FormatQuery(query))
+}
+
+// IsReadOnlyUser returns true, if transactions of the database user are
+// read-only by default, e.g. because `default_transaction_read_only' has been
+// set for the role of the user.
+func IsReadOnlyUser(ctx context.Context, db *bun.DB) (bool, error) {
+	var readOnly bool
+	err := db.QueryRowContext(ctx, "SELECT current_setting('default_transaction_read_only')::bool").Scan(&readOnly)
+
+	return readOnly, err
+}
+
+// beginReadOnlyTx starts a new read-only transaction, in which the
+// [DefaultQueryLimits] apply.
+func beginReadOnlyTx(ctx context.Context, db *bun.DB) (bun.Tx, error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return tx, err
+	}
+
+	if err := applyQueryLimits(ctx, tx, DefaultQueryLimits); err != nil {
+		_ = tx.Rollback()
+
+		return tx, err
+	}
+
+	return tx, nil
+}
+
+// applyQueryLimits applies the given [QueryLimits] to the transaction.
+func applyQueryLimits(ctx context.Context, tx bun.Tx, limits QueryLimits) error {
+	// Not all database drivers honour the read-only transaction option,
+	// so make sure it applies.
+	if _, err := tx.ExecContext(ctx, "SET TRANSACTION READ ONLY"); err != nil {
+		return err
+	}
+
+	if limits.StatementTimeout > 0 {
+		_, err := tx.ExecContext(ctx, "SET LOCAL statement_timeout = ?", limits.StatementTimeout.Milliseconds())
+		if err != nil {
+			return err
+		}
+	}
+
+	if limits.Role != "" {
+		if _, err := tx.ExecContext(ctx, "SET LOCAL ROLE ?", bun.Ident(limits.Role)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidateQuery(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		query   string
+		wantErr error
+	}{
+		{
+			desc:  "select",
+			query: "SELECT * FROM t",
+		},
+		{
+			desc:  "select with trailing semicolon",
+			query: "SELECT * FROM t;",
+		},
+		{
+			desc:  "common table expression",
+			query: "WITH x AS (SELECT 1) SELECT * FROM x",
+		},
+		{
+			desc:    "empty query",
+			query:   " ; -- nothing",
+			wantErr: ErrNoQuery,
+		},
+		{
+			desc:    "delete",
+			query:   "DELETE FROM t",
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:    "multiple statements",
+			query:   "SELECT 1; DELETE FROM t",
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:  "semicolon in string",
+			query: "SELECT ';DELETE FROM t'",
+		},
+		{
+			desc:  "doubled quote in string",
+			query: "SELECT 'it''s; DELETE FROM t'",
+		},
+		{
+			desc:  "semicolon in quoted identifier",
+			query: `SELECT 1 AS "a;""b"`,
+		},
+		{
+			desc:  "semicolon in escape string",
+			query: `SELECT E'it\'s; DELETE FROM t'`,
+		},
+		{
+			desc:    "statements after escape string with escaped quote",
+			query:   `SELECT E'\''; COMMIT; DELETE FROM t; SELECT ''`,
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:    "statements after lowercase escape string",
+			query:   `SELECT e'\\'; DELETE FROM t`,
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:    "identifier ending with e before string",
+			query:   `SELECT type'\'; DELETE FROM t; SELECT '`,
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:  "semicolon in dollar-quoted string",
+			query: "SELECT $$;DELETE FROM t$$",
+		},
+		{
+			desc:  "semicolon in tagged dollar-quoted string",
+			query: "SELECT $tag$ $$;DELETE FROM t $tag$",
+		},
+		{
+			desc:    "statements after dollar-quoted string",
+			query:   "SELECT $a$x$a$; DELETE FROM t",
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:  "semicolon in line comment",
+			query: "SELECT 1 -- ; DELETE FROM t",
+		},
+		{
+			desc:  "semicolon in nested block comment",
+			query: "SELECT 1 /* a /* b */ ; DELETE FROM t */",
+		},
+		{
+			desc:    "statements after nested block comment",
+			query:   "SELECT 1 /* a /* b */ */; DELETE FROM t",
+			wantErr: ErrQueryNotAllowed,
+		},
+		{
+			desc:  "escaped placeholder",
+			query: `SELECT * FROM t WHERE data \? 'key'`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidateQuery(tc.query)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestQueryPlaceholders(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		query string
+		want  []string
+	}{
+		{
+			desc:  "no placeholders",
+			query: "SELECT * FROM t",
+			want:  []string{},
+		},
+		{
+			desc:  "named placeholders",
+			query: "SELECT * FROM t WHERE a = ?a AND b IN (?(b)) AND c = ?a",
+			want:  []string{"a", "b"},
+		},
+		{
+			desc:  "escaped placeholder",
+			query: `SELECT * FROM t WHERE data \?key AND a = ?a`,
+			want:  []string{"a"},
+		},
+		{
+			desc:  "positional placeholder",
+			query: "SELECT * FROM t WHERE a = ?",
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := QueryPlaceholders(tc.query)
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,598 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/civil"
+	dbclient "github.com/gardener/inventory/pkg/clients/db"
+	"github.com/gardener/inventory/pkg/metrics"
+	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+	odgclient "github.com/gardener/inventory-extension-odg/pkg/odg/client"
+)
+
+// Kind describes a kind of orphan resource, which is fetched from Inventory
+// into values of type T and reported as findings to the Delivery Service API.
+type Kind[T any] struct {
+	// TaskName specifies the name of the task, which reports the orphan
+	// resources of this kind.
+	TaskName string
+
+	// ProviderName specifies the name of the provider, from which the
+	// orphan resources originate from.
+	ProviderName apitypes.ProviderName
+
+	// ResourceKind specifies the kind of the orphan resources.
+	ResourceKind apitypes.ResourceKind
+
+	// Severity specifies the severity of the findings.
+	Severity apitypes.SeverityLevel
+
+	// SeverityFunc optionally returns the severity of the finding for the
+	// given item, e.g. when it depends on whether the resource is publicly
+	// accessible. When it returns an empty severity, the finding is
+	// reported with Severity.
+	SeverityFunc func(item T) apitypes.SeverityLevel
+
+	// Summary specifies a short summary of the findings.
+	Summary string
+
+	// ArtefactName returns the name of the artefact for the given item.
+	ArtefactName func(item T) string
+
+	// ResourceName returns the unique name of the orphan resource in the
+	// provider for the given item.
+	ResourceName func(item T) string
+
+	// ArtefactExtraID returns the extra identity of the artefact for the
+	// given item.
+	ArtefactExtraID func(item T) map[string]string
+
+	// Attributes optionally returns the attributes of the finding for the
+	// given item. When not set, the item itself is used as the attributes.
+	Attributes func(item T) any
+
+	// Validate optionally verifies that the given item can be reported as
+	// a finding. An error fails the task without applying any changes.
+	Validate func(item T) error
+}
+
+// streamSubmitSize specifies the number of new and changed findings and new
+// runtime artefacts, which are buffered while streaming orphan resources,
+// before they are submitted to the Delivery Service.
+const streamSubmitSize = 1000
+
+// Reporter reports orphan resources of a given [Kind] as findings to the
+// Delivery Service API.
+//
+// Reporter implements the [asynq.Handler] interface and may be registered
+// directly with the task registry.
+type Reporter[T any] struct {
+	kind Kind[T]
+}
+
+// NewReporter creates a new [Reporter] for the given [Kind].
+//
+// The resource kind of the [Kind] is reserved for the reporter, so that the
+// [TaskReportOrphanResources] task refuses to report findings for it.
+func NewReporter[T any](kind Kind[T]) *Reporter[T] {
+	r := &Reporter[T]{
+		kind: kind,
+	}
+	reserveResourceKind(kind.ResourceKind, kind.TaskName)
+
+	return r
+}
+
+// ProcessTask implements the [asynq.Handler] interface.
+func (r *Reporter[T]) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	payload, err := DecodePayload(t)
+	if err != nil {
+		return asynqutils.SkipRetry(err)
+	}
+
+	return r.process(ctx, t, payload)
+}
+
+// process reports the orphan resources for the given task and its decoded
+// payload.
+func (r *Reporter[T]) process(ctx context.Context, t *asynq.Task, payload *Payload) error {
+	dryRun := ForceDryRun || payload.DryRun
+	logger := asynqutils.GetLogger(ctx).With(
+		"provider_name", r.kind.ProviderName,
+		"resource_kind", r.kind.ResourceKind,
+		"dry_run", dryRun,
+	)
+
+	// 1., 2. and 3. Stream orphan resources from Inventory, create
+	// findings out of them, compute the difference with the existing
+	// findings and runtime artefacts for the artefact type, and submit new
+	// and changed ones in batches.
+	changes, discovered, err := r.computeChanges(ctx, logger, payload, dryRun)
+	if err != nil {
+		if errors.Is(err, ErrMaxRowsExceeded) || errors.Is(err, ErrInvalidResource) {
+			logger.Error("refusing to report orphan resources", "reason", err)
+
+			return asynqutils.SkipRetry(err)
+		}
+
+		return MaybeSkipRetry(err)
+	}
+	logger.Info("found orphan resources", "count", discovered)
+
+	// Metric about discovered orphan resources from Inventory
+	r.addMetric("discovered_resources", discoveredOrphanResourcesDesc, discovered)
+
+	plan := changes.plan()
+	plan.DryRun = dryRun
+	plan.ProviderName = r.kind.ProviderName
+	plan.ResourceKind = r.kind.ResourceKind
+	plan.ComponentName = payload.ComponentName
+	plan.ComponentVersion = payload.ComponentVersion
+	plan.Discovered = discovered
+
+	// Make sure that we are not about to wipe out the existing findings
+	// and runtime artefacts.
+	guardrailErr := r.checkGuardrail(payload, changes, discovered)
+	if guardrailErr != nil {
+		plan.Guardrail = guardrailErr.Error()
+	}
+
+	// In dry-run mode we only report the plan, without having applied any
+	// changes.
+	if dryRun {
+		logger.Info("dry run, not applying changes", "plan", plan)
+
+		return writePlan(t, plan)
+	}
+
+	triggered := 0
+	if guardrailErr != nil {
+		triggered = 1
+	}
+	r.addMetric("guardrail_triggered", guardrailTriggeredDesc, triggered)
+	if guardrailErr != nil {
+		logger.Error("refusing to delete existing findings", "reason", guardrailErr)
+
+		return asynqutils.SkipRetry(guardrailErr)
+	}
+
+	// 4. Delete vanished findings and runtime artefacts
+	if err := r.applyDeletions(ctx, logger, payload, changes); err != nil {
+		return MaybeSkipRetry(err)
+	}
+
+	// Metric about successfully reported orphan resources to ODG.
+	r.addMetric("reported_resources", reportedOrphanResourcesDesc, discovered)
+
+	// 5. Record that the scan happened, even if no orphan resources have
+	// been found.
+	if err := r.deleteStaleScanInfos(ctx, logger, payload); err != nil {
+		// The stale scan infos are deleted on the next run.
+		logger.Warn("cannot delete stale scan infos", "reason", err)
+	}
+
+	logger.Info("submitting scan info", "discovered", discovered)
+	if err := odgclient.Client.SubmitArtefactScanInfo(ctx, r.scanInfo(payload, changes, discovered)); err != nil {
+		return MaybeSkipRetry(err)
+	}
+
+	// Changes have been applied already, so failing to write the plan
+	// should not fail the task.
+	if err := writePlan(t, plan); err != nil {
+		logger.Warn("cannot write plan", "reason", err)
+	}
+
+	return nil
+}
+
+// computeChanges fetches the existing findings and runtime artefacts from the
+// Delivery Service, then streams the orphan resources from Inventory and
+// computes the changes needed to reconcile the Delivery Service with them.
+//
+// New and changed findings, along with new runtime artefacts, are submitted
+// in batches of [streamSubmitSize] while streaming, so that they are not
+// retained in memory until the stream ends. In dry-run mode they are only
+// recorded in the changes. Deletions are computed once the stream ends, and
+// are left to the caller, so that they can be checked against the guardrail
+// first.
+//
+// It returns the changes along with the number of discovered orphan
+// resources.
+func (r *Reporter[T]) computeChanges(ctx context.Context, logger *slog.Logger, payload *Payload, dryRun bool) (*changeset, int, error) {
+	existingFindings, err := odgclient.Client.QueryArtefactMetadata(
+		ctx,
+		apitypes.DatatypeInventory,
+		apitypes.ComponentArtefactID{
+			ComponentName:    payload.ComponentName,
+			ComponentVersion: payload.ComponentVersion,
+			ArtefactKind:     apitypes.ArtefactKindRuntime,
+			Artefact: apitypes.LocalArtefactID{
+				ArtefactType: string(r.kind.ResourceKind),
+			},
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	labels := r.runtimeArtefactLabels(payload)
+	existingRuntimeArtefacts, err := odgclient.Client.QueryRuntimeArtefacts(ctx, labels)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	findings := newFindingsDiff(existingFindings)
+	runtimeArtefacts := newRuntimeArtefactsDiff(existingRuntimeArtefacts)
+
+	// submit submits the pending findings and runtime artefacts. Nothing
+	// has been deleted at this point, so there is nothing to restore on
+	// failure.
+	submit := func() error {
+		if findings.pending() == 0 && runtimeArtefacts.pending() == 0 {
+			return nil
+		}
+
+		if !dryRun {
+			logger.Info(
+				"submitting new and changed orphan resources to odg",
+				"new", len(findings.toCreate),
+				"changed", len(findings.toUpdate),
+				"runtime_artefacts", runtimeArtefacts.pending(),
+				"component_name", payload.ComponentName,
+				"component_version", payload.ComponentVersion,
+			)
+		}
+
+		pendingFindings := findings.drain()
+		pendingRuntimeArtefacts := runtimeArtefacts.drain()
+		if dryRun {
+			return nil
+		}
+
+		if err := odgclient.Client.SubmitArtefactMetadata(ctx, pendingFindings...); err != nil {
+			return r.compensate(ctx, logger, stageSubmitFindings, err)
+		}
+
+		if err := odgclient.Client.SubmitRuntimeArtefact(ctx, labels, pendingRuntimeArtefacts...); err != nil {
+			return r.compensate(ctx, logger, stageSubmitRuntimeArtefacts, err)
+		}
+
+		return nil
+	}
+
+	maxRows := payload.MaxRows
+	if maxRows <= 0 {
+		maxRows = DefaultQueryLimits.MaxRows
+	}
+
+	now := time.Now()
+	discovered := 0
+	for item, err := range StreamResourcesFromDB[T](ctx, dbclient.DB, payload.Query, payload.Params) {
+		if err != nil {
+			return nil, 0, err
+		}
+
+		discovered++
+		if maxRows > 0 && discovered > maxRows {
+			return nil, 0, fmt.Errorf("%w: more than %d rows returned", ErrMaxRowsExceeded, maxRows)
+		}
+
+		if r.kind.Validate != nil {
+			if err := r.kind.Validate(item); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		// Finding item
+		finding := apitypes.ArtefactMetadata{
+			Meta: apitypes.Metadata{
+				Datasource:   apitypes.DatasourceInventory,
+				Type:         apitypes.DatatypeInventory,
+				CreationDate: now,
+				LastUpdate:   now,
+			},
+			Artefact: r.artefactID(payload, item),
+			Data: apitypes.Finding{
+				Severity:     r.severity(item),
+				ProviderName: r.kind.ProviderName,
+				ResourceKind: r.kind.ResourceKind,
+				ResourceName: r.kind.ResourceName(item),
+				Summary:      r.kind.Summary,
+				Attributes:   r.attributes(item),
+			},
+			DiscoveryDate: civil.DateOf(now),
+		}
+		findings.add(finding)
+
+		// Runtime artefact item for each finding
+		runtimeArtefacts.add(r.artefactID(payload, item))
+
+		if findings.pending()+runtimeArtefacts.pending() >= streamSubmitSize {
+			if err := submit(); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+
+	if err := submit(); err != nil {
+		return nil, 0, err
+	}
+
+	changes := &changeset{
+		existingFindings:         len(existingFindings),
+		findingsToDelete:         findings.toDelete(),
+		findingsCreated:          findings.created,
+		findingsUpdated:          findings.updated,
+		scanTime:                 now,
+		existingRuntimeArtefacts: len(existingRuntimeArtefacts),
+		runtimeArtefactsToDelete: runtimeArtefacts.toDelete(),
+		runtimeArtefactsCreated:  runtimeArtefacts.created,
+	}
+
+	return changes, discovered, nil
+}
+
+// applyDeletions deletes the vanished findings and runtime artefacts from the
+// Delivery Service.
+//
+// If deleting fails after existing entries may have already been deleted,
+// including when only some batches of a deletion have failed, then the
+// entries to delete are re-submitted as a compensating action.
+func (r *Reporter[T]) applyDeletions(ctx context.Context, logger *slog.Logger, payload *Payload, changes *changeset) error {
+	logger.Info(
+		"deleting vanished orphan resources from odg",
+		"existing", changes.existingFindings,
+		"count", len(changes.findingsToDelete),
+	)
+	restoreFindings := restoreStep{
+		entries: entriesFindings,
+		count:   len(changes.findingsToDelete),
+		restore: func(ctx context.Context) error {
+			return odgclient.Client.SubmitArtefactMetadata(ctx, changes.findingsToDelete...)
+		},
+	}
+	if err := odgclient.Client.DeleteArtefactMetadata(ctx, changes.findingsToDelete...); err != nil {
+		// Findings are deleted in batches, so some of them may have
+		// been deleted already. Submitting findings is idempotent, so
+		// all of them are restored.
+		return r.compensate(ctx, logger, stageDeleteFindings, err, restoreFindings)
+	}
+
+	logger.Info(
+		"deleting vanished runtime artefacts from odg",
+		"existing", changes.existingRuntimeArtefacts,
+		"count", len(changes.runtimeArtefactsToDelete),
+	)
+	labels := r.runtimeArtefactLabels(payload)
+	restoreRuntimeArtefacts := restoreStep{
+		entries: entriesRuntimeArtefacts,
+		count:   len(changes.runtimeArtefactsToDelete),
+		restore: func(ctx context.Context) error {
+			items := make([]apitypes.ComponentArtefactID, 0, len(changes.runtimeArtefactsToDelete))
+			for _, item := range changes.runtimeArtefactsToDelete {
+				items = append(items, item.Spec.Artefact)
+			}
+
+			return odgclient.Client.SubmitRuntimeArtefact(ctx, labels, items...)
+		},
+	}
+
+	names := make([]string, 0, len(changes.runtimeArtefactsToDelete))
+	for _, item := range changes.runtimeArtefactsToDelete {
+		names = append(names, item.Metadata.Name)
+	}
+	if err := odgclient.Client.DeleteRuntimeArtefacts(ctx, names...); err != nil {
+		// Runtime artefacts are deleted in batches, so some of them
+		// may have been deleted already. Submitting runtime artefacts
+		// is idempotent, so all of them are restored. The vanished
+		// findings have been deleted already, so they are restored as
+		// well, in reverse order of deletion.
+		return r.compensate(ctx, logger, stageDeleteRuntimeArtefacts, err, restoreRuntimeArtefacts, restoreFindings)
+	}
+
+	return nil
+}
+
+// checkGuardrail verifies that the deletion of existing findings and runtime
+// artefacts does not violate the [DefaultGuardrail], unless the payload
+// explicitly requests forced deletion.
+func (r *Reporter[T]) checkGuardrail(payload *Payload, changes *changeset, discovered int) error {
+	if payload.ForceDeletion {
+		return nil
+	}
+
+	var errs []error
+	if err := DefaultGuardrail.Check(changes.existingFindings, discovered, len(changes.findingsToDelete)); err != nil {
+		errs = append(errs, fmt.Errorf("findings: %w", err))
+	}
+	if err := DefaultGuardrail.Check(changes.existingRuntimeArtefacts, discovered, len(changes.runtimeArtefactsToDelete)); err != nil {
+		errs = append(errs, fmt.Errorf("runtime artefacts: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// artefactID returns the [apitypes.ComponentArtefactID] for the given item,
+// which is used for both findings and runtime artefacts.
+func (r *Reporter[T]) artefactID(payload *Payload, item T) apitypes.ComponentArtefactID {
+	id := apitypes.ComponentArtefactID{
+		ComponentName:    payload.ComponentName,
+		ComponentVersion: payload.ComponentVersion,
+		Artefact: apitypes.LocalArtefactID{
+			ArtefactName:    r.kind.ArtefactName(item),
+			ArtefactType:    string(r.kind.ResourceKind),
+			ArtefactVersion: payload.ComponentVersion,
+			ArtefactExtraID: r.kind.ArtefactExtraID(item),
+		},
+		ArtefactKind: apitypes.ArtefactKindRuntime,
+	}
+
+	return id
+}
+
+// scanInfo returns the component-level [apitypes.ArtefactScanInfoMetadata],
+// which records the outcome of the scan for the kind of orphan resources.
+func (r *Reporter[T]) scanInfo(payload *Payload, changes *changeset, discovered int) apitypes.ArtefactScanInfoMetadata {
+	item := apitypes.ArtefactScanInfoMetadata{
+		Meta: apitypes.Metadata{
+			Datasource:   apitypes.DatasourceInventory,
+			Type:         apitypes.DatatypeArtefactScanInfo,
+			CreationDate: changes.scanTime,
+			LastUpdate:   changes.scanTime,
+		},
+		Artefact: apitypes.ComponentArtefactID{
+			ComponentName:    payload.ComponentName,
+			ComponentVersion: payload.ComponentVersion,
+			ArtefactKind:     apitypes.ArtefactKindRuntime,
+			Artefact: apitypes.LocalArtefactID{
+				ArtefactType:    string(r.kind.ResourceKind),
+				ArtefactVersion: payload.ComponentVersion,
+			},
+		},
+		Data: apitypes.ArtefactScanInfo{
+			Attributes: &apitypes.ArtefactScanAttributes{
+				ProviderName:    r.kind.ProviderName,
+				ResourceKind:    r.kind.ResourceKind,
+				Discovered:      discovered,
+				FindingsCreated: len(changes.findingsCreated),
+				FindingsUpdated: len(changes.findingsUpdated),
+				FindingsDeleted: len(changes.findingsToDelete),
+			},
+		},
+		DiscoveryDate: civil.DateOf(changes.scanTime),
+	}
+
+	return item
+}
+
+// staleScanInfosDeleted records the component artefacts, for which the stale
+// scan infos have already been deleted by this process.
+var staleScanInfosDeleted sync.Map
+
+// deleteStaleScanInfos deletes the scan infos, which have been submitted for
+// each finding by previous versions of the extension, and have been superseded
+// by the component-level scan info. Unlike the component-level scan info, they
+// are associated with the name of the artefact.
+//
+// The stale scan infos are deleted exactly as they have been returned by the
+// Delivery Service. This is a one-time migration, which is performed only once
+// per process for each component and kind of orphan resources.
+func (r *Reporter[T]) deleteStaleScanInfos(ctx context.Context, logger *slog.Logger, payload *Payload) error {
+	id := apitypes.ComponentArtefactID{
+		ComponentName:    payload.ComponentName,
+		ComponentVersion: payload.ComponentVersion,
+		ArtefactKind:     apitypes.ArtefactKindRuntime,
+		Artefact: apitypes.LocalArtefactID{
+			ArtefactType: string(r.kind.ResourceKind),
+		},
+	}
+	key := fmt.Sprintf("%s:%s:%s", id.ComponentName, id.ComponentVersion, id.Artefact.ArtefactType)
+	if _, ok := staleScanInfosDeleted.Load(key); ok {
+		return nil
+	}
+
+	scanInfos, err := odgclient.Client.QueryRawArtefactMetadata(ctx, apitypes.DatatypeArtefactScanInfo, id)
+	if err != nil {
+		return err
+	}
+
+	stale := slices.DeleteFunc(scanInfos, func(item apitypes.RawArtefactMetadata) bool {
+		return item.Artefact.Artefact.ArtefactName == ""
+	})
+	if len(stale) > 0 {
+		logger.Info("deleting stale scan infos", "count", len(stale))
+		if err := odgclient.Client.DeleteRawArtefactMetadata(ctx, stale...); err != nil {
+			return err
+		}
+	}
+
+	staleScanInfosDeleted.Store(key, struct{}{})
+
+	return nil
+}
+
+// severity returns the severity of the finding for the given item.
+func (r *Reporter[T]) severity(item T) apitypes.SeverityLevel {
+	if r.kind.SeverityFunc != nil {
+		if severity := r.kind.SeverityFunc(item); severity != "" {
+			return severity
+		}
+	}
+
+	return r.kind.Severity
+}
+
+// attributes returns the attributes of the finding for the given item.
+func (r *Reporter[T]) attributes(item T) any {
+	if r.kind.Attributes != nil {
+		return r.kind.Attributes(item)
+	}
+
+	return item
+}
+
+// publicAccessSeverity returns the severity of a finding for a resource, whose
+// severity depends on whether it is publicly accessible. It returns an empty
+// severity for resources, which are not publicly accessible, so that the
+// severity of the [Kind] is used.
+func publicAccessSeverity(public bool) apitypes.SeverityLevel {
+	if public {
+		return apitypes.SeverityLevelHigh
+	}
+
+	return ""
+}
+
+// danglingRecordSeverity returns the severity of a finding for a DNS record,
+// whose severity depends on whether it targets an address, which is no longer
+// owned, and is therefore prone to subdomain takeover. It returns an empty
+// severity for records, which are not dangling, so that the severity of the
+// [Kind] is used.
+func danglingRecordSeverity(dangling bool) apitypes.SeverityLevel {
+	if dangling {
+		return apitypes.SeverityLevelHigh
+	}
+
+	return ""
+}
+
+// runtimeArtefactLabels returns the labels with which runtime artefacts
+// created by the [Reporter] are associated.
+func (r *Reporter[T]) runtimeArtefactLabels(payload *Payload) map[string]string {
+	labels := map[string]string{
+		"created-by":     string(apitypes.DatasourceInventory),
+		"resource-kind":  string(r.kind.ResourceKind),
+		"component-name": payload.ComponentName,
+	}
+
+	return labels
+}
+
+// addMetric records a gauge metric with the given name and descriptor for the
+// [Kind] of the [Reporter].
+func (r *Reporter[T]) addMetric(name string, desc *prometheus.Desc, value int) {
+	metrics.DefaultCollector.AddMetric(
+		metrics.Key(r.kind.TaskName, name, string(r.kind.ProviderName), string(r.kind.ResourceKind)),
+		prometheus.MustNewConstMetric(
+			desc,
+			prometheus.GaugeValue,
+			float64(value),
+			string(r.kind.ProviderName),
+			string(r.kind.ResourceKind),
+		),
+	)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/gardener/inventory/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ErrRollbackFailed is an error, which is returned by task handlers, when
+// applying the changes to the Delivery Service failed part way, and the
+// compensating rollback of the already deleted entries failed as well.
+var ErrRollbackFailed = errors.New("compensating rollback failed")
+
+// rollbackTimeout specifies the max amount of time to spend on a compensating
+// rollback.
+const rollbackTimeout = time.Minute
+
+// Stages of applying the changes to the Delivery Service, at which a partial
+// failure may occur.
+const (
+	stageDeleteFindings         = "delete_findings"
+	stageSubmitFindings         = "submit_findings"
+	stageDeleteRuntimeArtefacts = "delete_runtime_artefacts"
+	stageSubmitRuntimeArtefacts = "submit_runtime_artefacts"
+)
+
+// Outcomes of a partial failure.
+const (
+	outcomeRolledBack     = "rolled_back"
+	outcomeRollbackFailed = "rollback_failed"
+	outcomeNoRollback     = "no_rollback"
+)
+
+// Kinds of entries, which are restored by a compensating rollback.
+const (
+	entriesNone             = "none"
+	entriesFindings         = "findings"
+	entriesRuntimeArtefacts = "runtime_artefacts"
+)
+
+// restoreFunc is a function, which restores previously deleted entries in the
+// Delivery Service.
+type restoreFunc func(ctx context.Context) error
+
+// restoreStep restores previously deleted entries of a given kind as part of a
+// compensating rollback.
+type restoreStep struct {
+	// entries specifies the kind of entries, which are restored.
+	entries string
+
+	// count specifies the number of entries, which are restored.
+	count int
+
+	// restore re-submits the deleted entries.
+	restore restoreFunc
+}
+
+// compensate handles a partial failure, which occurred at the given stage of
+// applying the changes to the Delivery Service.
+//
+// The given restore steps re-submit the entries, which have been deleted
+// before the failure, so that the Delivery Service is not left without them
+// until the next successful run. All steps are performed, regardless of
+// failures in other steps, and the outcome of each step is recorded
+// separately. Steps without entries are skipped, and no steps mean that there
+// is nothing to restore.
+//
+// The returned error always wraps the original cause.
+func (r *Reporter[T]) compensate(ctx context.Context, logger *slog.Logger, stage string, cause error, steps ...restoreStep) error {
+	steps = slices.DeleteFunc(slices.Clone(steps), func(step restoreStep) bool {
+		return step.restore == nil || step.count == 0
+	})
+	if len(steps) == 0 {
+		r.addPartialFailureMetric(stage, entriesNone, outcomeNoRollback, 0)
+		logger.Error("partial failure", "stage", stage, "outcome", outcomeNoRollback, "reason", cause)
+
+		return cause
+	}
+
+	// The original context may have been cancelled already, which is
+	// probably why we got here in the first place.
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	errs := []error{cause}
+	for _, step := range steps {
+		if err := step.restore(rollbackCtx); err != nil {
+			r.addPartialFailureMetric(stage, step.entries, outcomeRollbackFailed, step.count)
+			logger.Error(
+				"partial failure",
+				"stage", stage,
+				"entries", step.entries,
+				"outcome", outcomeRollbackFailed,
+				"count", step.count,
+				"reason", cause,
+				"rollback_reason", err,
+			)
+			errs = append(errs, fmt.Errorf("%w: %s: %w", ErrRollbackFailed, step.entries, err))
+
+			continue
+		}
+
+		r.addPartialFailureMetric(stage, step.entries, outcomeRolledBack, step.count)
+		logger.Warn(
+			"partial failure",
+			"stage", stage,
+			"entries", step.entries,
+			"outcome", outcomeRolledBack,
+			"count", step.count,
+			"reason", cause,
+		)
+	}
+
+	if len(errs) == 1 {
+		return cause
+	}
+
+	return errors.Join(errs...)
+}
+
+// addPartialFailureMetric records a metric about a partial failure at the given
+// stage with the given outcome of restoring the given kind of entries.
+func (r *Reporter[T]) addPartialFailureMetric(stage, entries, outcome string, count int) {
+	metrics.DefaultCollector.AddMetric(
+		metrics.Key(
+			r.kind.TaskName,
+			"partial_failure",
+			stage,
+			entries,
+			string(r.kind.ProviderName),
+			string(r.kind.ResourceKind),
+		),
+		prometheus.MustNewConstMetric(
+			partialFailureDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			string(r.kind.ProviderName),
+			string(r.kind.ResourceKind),
+			stage,
+			entries,
+			outcome,
+		),
+	)
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+)
+
+func TestCompensate(t *testing.T) {
+	errCause := errors.New("delete failed")
+	errRestore := errors.New("restore failed")
+
+	testCases := []struct {
+		desc         string
+		steps        []string
+		failing      []string
+		counts       map[string]int
+		wantRestored []string
+		wantRollback bool
+	}{
+		{
+			desc:         "nothing to restore",
+			steps:        []string{},
+			wantRestored: []string{},
+		},
+		{
+			desc:         "no deleted entries",
+			steps:        []string{entriesFindings},
+			counts:       map[string]int{entriesFindings: 0},
+			wantRestored: []string{},
+		},
+		{
+			desc:         "findings restored",
+			steps:        []string{entriesFindings},
+			wantRestored: []string{entriesFindings},
+		},
+		{
+			desc:         "runtime artefacts and findings restored",
+			steps:        []string{entriesRuntimeArtefacts, entriesFindings},
+			wantRestored: []string{entriesRuntimeArtefacts, entriesFindings},
+		},
+		{
+			desc:         "findings restored after runtime artefacts failed",
+			steps:        []string{entriesRuntimeArtefacts, entriesFindings},
+			failing:      []string{entriesRuntimeArtefacts},
+			wantRestored: []string{entriesRuntimeArtefacts, entriesFindings},
+			wantRollback: true,
+		},
+		{
+			desc:         "findings failed to restore",
+			steps:        []string{entriesFindings},
+			failing:      []string{entriesFindings},
+			wantRestored: []string{entriesFindings},
+			wantRollback: true,
+		},
+	}
+
+	r := &Reporter[map[string]any]{
+		kind: Kind[map[string]any]{
+			TaskName:     "test",
+			ProviderName: apitypes.ProviderNameAWS,
+			ResourceKind: apitypes.ResourceKindVirtualMachineAWS,
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			restored := make([]string, 0)
+			steps := make([]restoreStep, 0, len(tc.steps))
+			for _, entries := range tc.steps {
+				count, ok := tc.counts[entries]
+				if !ok {
+					count = 1
+				}
+
+				steps = append(steps, restoreStep{
+					entries: entries,
+					count:   count,
+					restore: func(ctx context.Context) error {
+						if ctx.Err() != nil {
+							t.Fatalf("want active context for restoring %s", entries)
+						}
+						restored = append(restored, entries)
+						if slices.Contains(tc.failing, entries) {
+							return errRestore
+						}
+
+						return nil
+					},
+				})
+			}
+
+			// The rollback must not be affected by the cancelled
+			// context of the task.
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := r.compensate(ctx, logger, stageDeleteRuntimeArtefacts, errCause, steps...)
+			if !errors.Is(err, errCause) {
+				t.Fatalf("want error %v, got %v", errCause, err)
+			}
+
+			if got := errors.Is(err, ErrRollbackFailed); got != tc.wantRollback {
+				t.Fatalf("want rollback failure %t, got %v", tc.wantRollback, err)
+			}
+
+			if !slices.Equal(restored, tc.wantRestored) {
+				t.Fatalf("want restored %v, got %v", tc.wantRestored, restored)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+)
+
+// artefactKey returns a key, which uniquely identifies the given
+// [apitypes.ComponentArtefactID].
+//
+// The key is built from the fields of the artefact, including the extra
+// identity sorted by its keys, so that artefacts built locally match the ones
+// retrieved from the Delivery Service. Each field is quoted, so that distinct
+// artefacts cannot produce the same key.
+func artefactKey(id apitypes.ComponentArtefactID) string {
+	fields := []string{
+		id.ComponentName,
+		id.ComponentVersion,
+		string(id.ArtefactKind),
+		id.Artefact.ArtefactName,
+		id.Artefact.ArtefactType,
+		id.Artefact.ArtefactVersion,
+	}
+	for _, k := range slices.Sorted(maps.Keys(id.Artefact.ArtefactExtraID)) {
+		fields = append(fields, k, id.Artefact.ArtefactExtraID[k])
+	}
+
+	for i, field := range fields {
+		fields[i] = strconv.Quote(field)
+	}
+
+	return strings.Join(fields, ":")
+}
+
+// normalizeFinding returns the JSON representation of the given
+// [apitypes.Finding] decoded into a generic value, so that findings built
+// locally can be compared with findings retrieved from the Delivery Service.
+func normalizeFinding(f apitypes.Finding) (any, error) {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// findingChanged returns true, if the data of the given findings differs.
+func findingChanged(existing, desired apitypes.Finding) bool {
+	a, err := normalizeFinding(existing)
+	if err != nil {
+		return true
+	}
+
+	b, err := normalizeFinding(desired)
+	if err != nil {
+		return true
+	}
+
+	return !reflect.DeepEqual(a, b)
+}
+
+// findingsDiff computes the difference between the findings, which already
+// exist in the Delivery Service, and the desired findings, which are added one
+// at a time.
+//
+// New and changed findings are kept as pending, until they are drained for
+// submission. Apart from their keys and identifiers, desired findings are not
+// retained once drained, so that memory usage does not depend on the size of
+// the desired findings.
+type findingsDiff struct {
+	// existing contains the existing findings by key.
+	existing map[string]apitypes.ArtefactMetadata
+
+	// duplicates contains existing findings, which refer to the same
+	// artefact as another existing finding.
+	duplicates []apitypes.ArtefactMetadata
+
+	// seen contains the keys of the desired findings.
+	seen map[string]struct{}
+
+	// toCreate contains the pending desired findings, which are new.
+	toCreate []apitypes.ArtefactMetadata
+
+	// toUpdate contains the pending desired findings, which have changed.
+	toUpdate []apitypes.ArtefactMetadata
+
+	// created contains the identifiers of the desired findings, which are
+	// new.
+	created []string
+
+	// updated contains the identifiers of the desired findings, which
+	// have changed.
+	updated []string
+}
+
+// newFindingsDiff creates a new [findingsDiff] against the given existing
+// findings.
+func newFindingsDiff(existing []apitypes.ArtefactMetadata) *findingsDiff {
+	d := &findingsDiff{
+		existing:   make(map[string]apitypes.ArtefactMetadata, len(existing)),
+		duplicates: make([]apitypes.ArtefactMetadata, 0),
+		seen:       make(map[string]struct{}),
+		toCreate:   make([]apitypes.ArtefactMetadata, 0),
+		toUpdate:   make([]apitypes.ArtefactMetadata, 0),
+		created:    make([]string, 0),
+		updated:    make([]string, 0),
+	}
+
+	for _, item := range existing {
+		key := artefactKey(item.Artefact)
+		if _, ok := d.existing[key]; ok {
+			d.duplicates = append(d.duplicates, item)
+
+			continue
+		}
+		d.existing[key] = item
+	}
+
+	return d
+}
+
+// add adds the given desired finding to the diff.
+//
+// If the finding already exists, it retains its original discovery and
+// creation dates. Desired findings, which refer to the same artefact as a
+// previously added finding are ignored.
+func (d *findingsDiff) add(item apitypes.ArtefactMetadata) {
+	key := artefactKey(item.Artefact)
+	if _, ok := d.seen[key]; ok {
+		return
+	}
+	d.seen[key] = struct{}{}
+
+	old, ok := d.existing[key]
+	if !ok {
+		d.toCreate = append(d.toCreate, item)
+		d.created = append(d.created, findingID(item))
+
+		return
+	}
+
+	// Resource is still orphan, retain the original dates
+	item.DiscoveryDate = old.DiscoveryDate
+	item.Meta.CreationDate = old.Meta.CreationDate
+	if findingChanged(old.Data, item.Data) {
+		d.toUpdate = append(d.toUpdate, item)
+		d.updated = append(d.updated, findingID(item))
+	}
+}
+
+// pending returns the number of pending new and changed findings.
+func (d *findingsDiff) pending() int {
+	return len(d.toCreate) + len(d.toUpdate)
+}
+
+// drain returns the pending new and changed findings, and clears them.
+func (d *findingsDiff) drain() []apitypes.ArtefactMetadata {
+	result := slices.Concat(d.toCreate, d.toUpdate)
+	d.toCreate = make([]apitypes.ArtefactMetadata, 0)
+	d.toUpdate = make([]apitypes.ArtefactMetadata, 0)
+
+	return result
+}
+
+// toDelete returns the existing findings, which are not desired and need to be
+// deleted.
+func (d *findingsDiff) toDelete() []apitypes.ArtefactMetadata {
+	result := slices.Clone(d.duplicates)
+	for key, item := range d.existing {
+		if _, ok := d.seen[key]; !ok {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// runtimeArtefactsDiff computes the difference between the runtime artefacts,
+// which already exist in the Delivery Service, and the desired runtime
+// artefacts, which are added one at a time.
+type runtimeArtefactsDiff struct {
+	// existing contains the existing runtime artefacts by key.
+	existing map[string]apitypes.RuntimeArtefactResultItem
+
+	// duplicates contains existing runtime artefacts, which refer to the
+	// same artefact as another existing runtime artefact.
+	duplicates []apitypes.RuntimeArtefactResultItem
+
+	// seen contains the keys of the desired runtime artefacts.
+	seen map[string]struct{}
+
+	// toCreate contains the pending desired runtime artefacts, which are
+	// new.
+	toCreate []apitypes.ComponentArtefactID
+
+	// created contains the identifiers of the desired runtime artefacts,
+	// which are new.
+	created []string
+}
+
+// newRuntimeArtefactsDiff creates a new [runtimeArtefactsDiff] against the
+// given existing runtime artefacts.
+func newRuntimeArtefactsDiff(existing []apitypes.RuntimeArtefactResultItem) *runtimeArtefactsDiff {
+	d := &runtimeArtefactsDiff{
+		existing:   make(map[string]apitypes.RuntimeArtefactResultItem, len(existing)),
+		duplicates: make([]apitypes.RuntimeArtefactResultItem, 0),
+		seen:       make(map[string]struct{}),
+		toCreate:   make([]apitypes.ComponentArtefactID, 0),
+		created:    make([]string, 0),
+	}
+
+	for _, item := range existing {
+		key := artefactKey(item.Spec.Artefact)
+		if _, ok := d.existing[key]; ok {
+			d.duplicates = append(d.duplicates, item)
+
+			continue
+		}
+		d.existing[key] = item
+	}
+
+	return d
+}
+
+// add adds the given desired runtime artefact to the diff.
+func (d *runtimeArtefactsDiff) add(item apitypes.ComponentArtefactID) {
+	key := artefactKey(item)
+	if _, ok := d.seen[key]; ok {
+		return
+	}
+	d.seen[key] = struct{}{}
+
+	if _, ok := d.existing[key]; !ok {
+		d.toCreate = append(d.toCreate, item)
+		d.created = append(d.created, runtimeArtefactID(item))
+	}
+}
+
+// pending returns the number of pending new runtime artefacts.
+func (d *runtimeArtefactsDiff) pending() int {
+	return len(d.toCreate)
+}
+
+// drain returns the pending new runtime artefacts, and clears them.
+func (d *runtimeArtefactsDiff) drain() []apitypes.ComponentArtefactID {
+	result := d.toCreate
+	d.toCreate = make([]apitypes.ComponentArtefactID, 0)
+
+	return result
+}
+
+// toDelete returns the existing runtime artefacts, which are not desired and
+// need to be deleted.
+func (d *runtimeArtefactsDiff) toDelete() []apitypes.RuntimeArtefactResultItem {
+	result := slices.Clone(d.duplicates)
+	for key, item := range d.existing {
+		if _, ok := d.seen[key]; !ok {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
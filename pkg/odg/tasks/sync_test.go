@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+
+	apitypes "github.com/gardener/inventory-extension-odg/pkg/odg/api/types"
+)
+
+func testArtefactID(name string, extraID map[string]string) apitypes.ComponentArtefactID {
+	return apitypes.ComponentArtefactID{
+		ComponentName:    "component",
+		ComponentVersion: "v1",
+		ArtefactKind:     apitypes.ArtefactKindRuntime,
+		Artefact: apitypes.LocalArtefactID{
+			ArtefactName:    name,
+			ArtefactType:    string(apitypes.ResourceKindVirtualMachineAWS),
+			ArtefactVersion: "v1",
+			ArtefactExtraID: extraID,
+		},
+	}
+}
+
+func testFinding(name string, severity apitypes.SeverityLevel, discoveryDate civil.Date) apitypes.ArtefactMetadata {
+	return apitypes.ArtefactMetadata{
+		Artefact: testArtefactID(name, map[string]string{"region": "eu-west-1"}),
+		Meta: apitypes.Metadata{
+			Datasource:   apitypes.DatasourceInventory,
+			Type:         apitypes.DatatypeInventory,
+			CreationDate: discoveryDate.In(time.UTC),
+			LastUpdate:   discoveryDate.In(time.UTC),
+		},
+		Data: apitypes.Finding{
+			Severity:     severity,
+			ProviderName: apitypes.ProviderNameAWS,
+			ResourceKind: apitypes.ResourceKindVirtualMachineAWS,
+			ResourceName: name,
+			Summary:      "orphan virtual machine",
+			Attributes:   map[string]any{"count": 1},
+		},
+		DiscoveryDate: discoveryDate,
+	}
+}
+
+func TestArtefactKey(t *testing.T) {
+	testCases := []struct {
+		desc string
+		a    apitypes.ComponentArtefactID
+		b    apitypes.ComponentArtefactID
+		want bool
+	}{
+		{
+			desc: "same artefact",
+			a:    testArtefactID("vm-1", map[string]string{"region": "eu-west-1"}),
+			b:    testArtefactID("vm-1", map[string]string{"region": "eu-west-1"}),
+			want: true,
+		},
+		{
+			desc: "extra id in different order",
+			a:    testArtefactID("vm-1", map[string]string{"a": "1", "b": "2", "c": "3"}),
+			b:    testArtefactID("vm-1", map[string]string{"c": "3", "b": "2", "a": "1"}),
+			want: true,
+		},
+		{
+			desc: "nil and empty extra id",
+			a:    testArtefactID("vm-1", nil),
+			b:    testArtefactID("vm-1", map[string]string{}),
+			want: true,
+		},
+		{
+			desc: "different name",
+			a:    testArtefactID("vm-1", nil),
+			b:    testArtefactID("vm-2", nil),
+			want: false,
+		},
+		{
+			desc: "different extra id",
+			a:    testArtefactID("vm-1", map[string]string{"region": "eu-west-1"}),
+			b:    testArtefactID("vm-1", map[string]string{"region": "eu-west-2"}),
+			want: false,
+		},
+		{
+			desc: "separator in fields",
+			a:    testArtefactID("vm:1", map[string]string{"a": "b"}),
+			b:    testArtefactID("vm", map[string]string{"1:a": "b"}),
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := artefactKey(tc.a) == artefactKey(tc.b)
+			if got != tc.want {
+				t.Fatalf("want equal keys %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFindingChanged(t *testing.T) {
+	date := civil.Date{Year: 2025, Month: time.January, Day: 1}
+
+	testCases := []struct {
+		desc     string
+		existing apitypes.Finding
+		desired  apitypes.Finding
+		want     bool
+	}{
+		{
+			desc:     "same finding",
+			existing: testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data,
+			desired:  testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data,
+			want:     false,
+		},
+		{
+			desc: "attributes decoded from json",
+			existing: func() apitypes.Finding {
+				f := testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data
+				f.Attributes = map[string]any{"count": float64(1)}
+
+				return f
+			}(),
+			desired: testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data,
+			want:    false,
+		},
+		{
+			desc:     "different severity",
+			existing: testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data,
+			desired:  testFinding("vm-1", apitypes.SeverityLevelHigh, date).Data,
+			want:     true,
+		},
+		{
+			desc:     "different attributes",
+			existing: testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data,
+			desired: func() apitypes.Finding {
+				f := testFinding("vm-1", apitypes.SeverityLevelMedium, date).Data
+				f.Attributes = map[string]any{"count": 2}
+
+				return f
+			}(),
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := findingChanged(tc.existing, tc.desired)
+			if got != tc.want {
+				t.Fatalf("want changed %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFindingsDiff(t *testing.T) {
+	oldDate := civil.Date{Year: 2025, Month: time.January, Day: 1}
+	newDate := civil.Date{Year: 2025, Month: time.February, Day: 1}
+
+	existing := []apitypes.ArtefactMetadata{
+		testFinding("unchanged", apitypes.SeverityLevelMedium, oldDate),
+		testFinding("changed", apitypes.SeverityLevelMedium, oldDate),
+		testFinding("vanished", apitypes.SeverityLevelMedium, oldDate),
+		testFinding("duplicate", apitypes.SeverityLevelMedium, oldDate),
+		testFinding("duplicate", apitypes.SeverityLevelMedium, oldDate),
+	}
+
+	d := newFindingsDiff(existing)
+	d.add(testFinding("unchanged", apitypes.SeverityLevelMedium, newDate))
+	d.add(testFinding("changed", apitypes.SeverityLevelHigh, newDate))
+	d.add(testFinding("new", apitypes.SeverityLevelMedium, newDate))
+	d.add(testFinding("new", apitypes.SeverityLevelHigh, newDate))
+	d.add(testFinding("duplicate", apitypes.SeverityLevelMedium, newDate))
+
+	if got := d.pending(); got != 2 {
+		t.Fatalf("want 2 pending findings, got %d", got)
+	}
+
+	pending := d.drain()
+	if got := d.pending(); got != 0 {
+		t.Fatalf("want no pending findings after drain, got %d", got)
+	}
+
+	names := make([]string, 0, len(pending))
+	for _, item := range pending {
+		names = append(names, item.Data.ResourceName)
+	}
+	if want := []string{"new", "changed"}; !slices.Equal(names, want) {
+		t.Fatalf("want pending findings %v, got %v", want, names)
+	}
+
+	if got := pending[0].Data.Severity; got != apitypes.SeverityLevelMedium {
+		t.Fatalf("want the first added new finding, got severity %s", got)
+	}
+
+	if got := pending[1].DiscoveryDate; got != oldDate {
+		t.Fatalf("want changed finding to retain discovery date %s, got %s", oldDate, got)
+	}
+
+	if got := pending[1].Meta.CreationDate; !got.Equal(oldDate.In(time.UTC)) {
+		t.Fatalf("want changed finding to retain creation date %s, got %s", oldDate, got)
+	}
+
+	if want := []string{"new"}; !slices.Equal(d.created, want) {
+		t.Fatalf("want created findings %v, got %v", want, d.created)
+	}
+
+	if want := []string{"changed"}; !slices.Equal(d.updated, want) {
+		t.Fatalf("want updated findings %v, got %v", want, d.updated)
+	}
+
+	deleted := make([]string, 0)
+	for _, item := range d.toDelete() {
+		deleted = append(deleted, item.Data.ResourceName)
+	}
+	slices.Sort(deleted)
+	if want := []string{"duplicate", "vanished"}; !slices.Equal(deleted, want) {
+		t.Fatalf("want deleted findings %v, got %v", want, deleted)
+	}
+}
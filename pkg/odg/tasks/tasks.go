@@ -9,36 +9,94 @@
 //
 // 1. Fetch orphan resources from Inventory
 //
-// Get the orphan resources from Inventory first, then convert them to
-// findings, which the Delivery Service understands.
+// The orphan resources are streamed from Inventory one row at a time, and
+// each is converted to a finding, which the Delivery Service understands. The
+// existing findings and runtime artefacts are fetched from the Delivery Service
+// beforehand, so that findings which have not changed need not be retained in
+// memory, and new and changed findings can be submitted while streaming.
 //
-// 2. Wipe out old/previous findings for the artefact type
+// Memory usage therefore grows with the number of existing findings and
+// runtime artefacts, and with the number of rows only by the keys and names of
+// their findings. The number of rows may optionally be limited by the
+// [DefaultQueryLimits], or by the [Payload].
 //
-// We need to delete the old/previous findings for the artefact type
-// associated with the component name and version. This ensures no old
-// entries exist in the database, since the Delivery Service does not
-// have a retention mechanism for cleaning up such findings.
+// The query is either provided as part of the [Payload], or taken from the
+// [QueryCatalog]. It must be a single SELECT statement, which is executed in a
+// read-only transaction with the configured [QueryLimits].
 //
-// Also, we need to delete old/previous runtime artefacts for each finding.
+// 2. Compute the difference with the existing findings for the artefact type
 //
-// 3. Submit the orphan resources from step 1
+// The existing findings for the artefact type associated with the component
+// name and version are compared with the findings from step 1. Findings for
+// resources, which are no longer orphan are deleted, since the Delivery Service
+// does not have a retention mechanism for cleaning up such findings.
 //
-// The latest orphan resources fetched from step 1 are submitted to the
-// Delivery Service API.
+// 3. Submit new and changed findings and runtime artefacts
 //
-// 4. Create runtime artefacts, so that findings can be evaluated and compliance
-// issues created or updated for them.
+// Findings for newly discovered orphan resources, and findings whose data has
+// changed are submitted to the Delivery Service API in batches, while the
+// orphan resources are being streamed. Resources, which are still orphan
+// retain their original discovery date, so that the Delivery Service can track
+// the real age of the finding. Runtime artefacts for newly discovered orphan
+// resources are created along with the findings, so that findings can be
+// evaluated and compliance issues created or updated for them.
 //
-// If we have no orphan resources to report, then we don't report anything to
-// the remote API.
+// 4. Delete vanished findings and runtime artefacts
+//
+// Findings and runtime artefacts for resources, which are no longer orphan are
+// deleted, once the stream of orphan resources ends.
+//
+// 5. Submit scan info
+//
+// A single component-level scan info is submitted for the artefact type on
+// each run, which records the time of the scan in its metadata, and the number
+// of discovered orphan resources, along with the number of created, updated and
+// deleted findings in its attributes. This allows the Delivery Service to
+// distinguish between resources which have been scanned and found clean, and
+// resources which have never been scanned. The scan infos, which previous
+// versions submitted for each finding, are deleted once per process
+// beforehand.
+//
+// Before deleting anything the task handlers verify that the deletions do not
+// exceed the [Guardrail], so that existing findings are not wiped out when the
+// query for orphan resources suddenly returns no results, e.g. because an
+// Inventory collection has been failing. The guardrail may be bypassed by
+// setting the `force_deletion' field of the [Payload].
+//
+// The guardrail only applies to deletions, so new and changed findings are
+// submitted even if the guardrail is triggered later on. The same applies when
+// the query fails part way, e.g. because it returns more rows than allowed.
+//
+// If deleting findings or runtime artefacts fails after some of the vanished
+// ones may have already been deleted, then the entries to delete are
+// re-submitted as a compensating action, so that the Delivery Service is not
+// left without them until the next successful run. Deletions are made in
+// batches, so a failed deletion may have deleted some of the entries already.
+// When deleting runtime artefacts fails, the findings deleted beforehand are
+// re-submitted as well.
+//
+// When the `dry_run' field of the [Payload] is set, the task handlers compute
+// the changes as described above, but instead of applying them, they log and
+// return a [Plan] as the task result.
+//
+// The flow above is implemented by [Reporter]. Supporting a new kind of orphan
+// resource requires a model for the query results, and a [Kind] describing how
+// the model maps to findings, which is then registered as a task handler.
+// Alternatively, the [TaskReportOrphanResources] task reports orphan resources
+// of any kind, as described by the [ResourceSpec] provided as part of the
+// [Payload].
 package tasks
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"regexp"
 	"slices"
+	"strings"
 
 	asynqutils "github.com/gardener/inventory/pkg/utils/asynq"
 	"github.com/hibiken/asynq"
@@ -52,9 +110,20 @@ import (
 var ErrNoPayload = errors.New("no payload specified")
 
 // ErrNoQuery is an error, which is returned by task handlers, which expect a
-// query to be provided as part of the payload, but none was provided.
+// query to be provided as part of the payload, but none was provided, and no
+// default query exists in the [QueryCatalog].
 var ErrNoQuery = errors.New("no query specified")
 
+// ErrMaxRowsExceeded is an error, which is returned by task handlers, when the
+// query returns more rows than the limit specified as part of the payload, or
+// by the [DefaultQueryLimits].
+var ErrMaxRowsExceeded = errors.New("max rows exceeded")
+
+// ErrMissingQueryParam is an error, which is returned by task handlers, when
+// the query references a named placeholder, for which no parameter was
+// provided as part of the payload.
+var ErrMissingQueryParam = errors.New("missing query parameter")
+
 // ErrNoComponentName is an error, which is returned by task handlers, which
 // expect an OCM component name to be specified as part of the payload, but none
 // was provided.
@@ -64,8 +133,19 @@ var ErrNoComponentName = errors.New("no component name specified")
 // resources to the Open Delivery Gear API.
 type Payload struct {
 	// Query represents the SQL query to use when fetching orphan resources.
+	// When no query is specified, the query named by QueryName is used
+	// from the [QueryCatalog].
 	Query string `yaml:"query" json:"query"`
 
+	// QueryName specifies the name of the query from the [QueryCatalog] to
+	// use when fetching orphan resources. Defaults to [DefaultQueryName].
+	QueryName string `yaml:"query_name" json:"query_name"`
+
+	// Params specifies the values for the named placeholders referenced by
+	// the query, e.g. `?account_id'. List values are expanded, so that
+	// they can be used with the `IN' operator.
+	Params map[string]any `yaml:"params" json:"params"`
+
 	// ComponentName specifies the name of the OCM component with which to
 	// associate the submitted findings.
 	ComponentName string `yaml:"component_name" json:"component_name"`
@@ -73,6 +153,36 @@ type Payload struct {
 	// ComponentVersion specifies the version of the OCM component with
 	// which to associate the submitted findings.
 	ComponentVersion string `yaml:"component_version" json:"component_version"`
+
+	// ForceDeletion specifies whether to delete existing findings and
+	// runtime artefacts, even if that would trigger the mass deletion
+	// [Guardrail].
+	ForceDeletion bool `yaml:"force_deletion" json:"force_deletion"`
+
+	// DryRun specifies whether to run in dry-run mode, in which case the
+	// changes to the Delivery Service are only computed and reported as a
+	// [Plan], but not applied.
+	DryRun bool `yaml:"dry_run" json:"dry_run"`
+
+	// MaxRows specifies the max number of rows, which the query may
+	// return. When the limit is exceeded the task fails without deleting
+	// any findings. A value of zero means the limit from the
+	// [DefaultQueryLimits] applies, if any.
+	MaxRows int `yaml:"max_rows" json:"max_rows"`
+
+	// Resource describes how the rows returned by the query map to
+	// findings. It is required by the [TaskReportOrphanResources] task
+	// only, and ignored by all other tasks.
+	Resource *ResourceSpec `yaml:"resource" json:"resource"`
+}
+
+// ForceDryRun specifies whether task handlers always run in dry-run mode,
+// regardless of the settings in their payload.
+var ForceDryRun = false
+
+// SetForceDryRun configures whether task handlers always run in dry-run mode.
+func SetForceDryRun(v bool) {
+	ForceDryRun = v
 }
 
 // DecodePayload decodes the payload for the given [asynq.Task].
@@ -87,26 +197,226 @@ func DecodePayload(t *asynq.Task) (*Payload, error) {
 		return nil, err
 	}
 
-	if payload.Query == "" {
-		return nil, ErrNoQuery
+	if err := resolveQuery(t.Type(), &payload); err != nil {
+		return nil, err
 	}
 
 	if payload.ComponentName == "" {
 		return nil, ErrNoComponentName
 	}
 
+	if err := ValidateQuery(payload.Query); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateQueryParams(payload.Query, payload.Params); err != nil {
+		return nil, err
+	}
+
 	return &payload, nil
 }
 
+// placeholderRegexp matches the named placeholders in a query, e.g. `?name'
+// and `?(name)', along with an optional escape character, following the same
+// rules used by [bun] when formatting queries.
+var placeholderRegexp = regexp.MustCompile(`(\\?)\?(?:\(([^)]*)\)|([0-9]*[A-Za-z][A-Za-z0-9_]*))`)
+
+// QueryPlaceholders returns the names of the named placeholders, which are
+// referenced by the given query.
+func QueryPlaceholders(query string) []string {
+	names := make([]string, 0)
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(query, -1) {
+		// Escaped placeholder
+		if match[1] != "" {
+			continue
+		}
+
+		name := match[2] + match[3]
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// ValidateQueryParams verifies that the given params provide a value for each
+// named placeholder, which is referenced by the query.
+func ValidateQueryParams(query string, params map[string]any) error {
+	missing := make([]string, 0)
+	for _, name := range QueryPlaceholders(query) {
+		if _, ok := params[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingQueryParam, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// withQueryParams returns a copy of the given [bun.DB], which binds the given
+// params to the named placeholders of the queries.
+func withQueryParams(db *bun.DB, params map[string]any) *bun.DB {
+	for name, value := range params {
+		if values, ok := value.([]any); ok {
+			value = bun.In(values)
+		}
+		db = db.WithNamedArg(name, value)
+	}
+
+	return db
+}
+
 // FetchResourcesFromDB fetches the resources from the database using the given
-// query into the given dest value.
-func FetchResourcesFromDB(ctx context.Context, db *bun.DB, query string, dest any) error {
-	return db.NewRaw(query).Scan(ctx, dest)
+// query and params into the given dest value.
+//
+// The query is executed in a read-only transaction, in which the
+// [DefaultQueryLimits] apply.
+//
+// Deprecated: FetchResourcesFromDB loads the result set into memory as a
+// whole. Use [StreamResourcesFromDB] instead.
+func FetchResourcesFromDB(ctx context.Context, db *bun.DB, query string, params map[string]any, dest any) error {
+	if err := ValidateQuery(query); err != nil {
+		return err
+	}
+
+	db = withQueryParams(db, params)
+	tx, err := beginReadOnlyTx(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	stmt, err := prepareQuery(ctx, db, tx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close() // nolint: errcheck
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close() // nolint: errcheck
+
+	return db.ScanRows(ctx, rows, dest)
+}
+
+// StreamResourcesFromDB returns an iterator over the resources fetched from the
+// database using the given query and params.
+//
+// Rows are scanned into values of type T one at a time as the iterator
+// advances, so that the result set is never loaded into memory as a whole.
+// Iteration stops after the first error.
+//
+// The query is executed in a read-only transaction, in which the
+// [DefaultQueryLimits] apply.
+func StreamResourcesFromDB[T any](ctx context.Context, db *bun.DB, query string, params map[string]any) iter.Seq2[T, error] {
+	db = withQueryParams(db, params)
+	seq := func(yield func(T, error) bool) {
+		var zero T
+		if err := ValidateQuery(query); err != nil {
+			yield(zero, err)
+
+			return
+		}
+
+		tx, err := beginReadOnlyTx(ctx, db)
+		if err != nil {
+			yield(zero, err)
+
+			return
+		}
+		defer tx.Rollback() // nolint: errcheck
+
+		stmt, err := prepareQuery(ctx, db, tx, query)
+		if err != nil {
+			yield(zero, err)
+
+			return
+		}
+		defer stmt.Close() // nolint: errcheck
+
+		rows, err := stmt.QueryContext(ctx)
+		if err != nil {
+			yield(zero, err)
+
+			return
+		}
+		defer rows.Close() // nolint: errcheck
+
+		for rows.Next() {
+			var item T
+			if err := scanRow(ctx, db, rows, &item); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+
+	return seq
+}
+
+// scanRow scans the current row into the given dest value.
+//
+// Rows are scanned into a map separately, since [bun.DB.ScanRow] does not
+// support maps.
+func scanRow(ctx context.Context, db *bun.DB, rows *sql.Rows, dest any) error {
+	m, ok := dest.(*map[string]any)
+	if !ok {
+		return db.ScanRow(ctx, rows, dest)
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, len(columns))
+	ptrs := make([]any, len(columns))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+
+	if err := rows.Scan(ptrs...); err != nil {
+		return err
+	}
+
+	result := make(map[string]any, len(columns))
+	for i, column := range columns {
+		// Byte slices are only valid until the next call to Scan.
+		if data, ok := values[i].([]byte); ok {
+			values[i] = string(data)
+		}
+		result[column] = values[i]
+	}
+	*m = result
+
+	return nil
 }
 
 // MaybeSkipRetry wraps known API errors with [asynq.SkipRetry], so that the
 // tasks which these errors originate from won't be retried.
+//
+// Errors caused by a failed re-authentication are always retried, since the
+// Delivery Service may be restarting.
 func MaybeSkipRetry(err error) error {
+	if errors.Is(err, apiclient.ErrReauthenticationFailed) {
+		return err
+	}
+
 	// Skip retry for the following HTTP status codes returned by the remote
 	// Delivery Service API.
 	skipHTTPCodes := []int{